@@ -0,0 +1,219 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/slogs"
+	icmd "github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/spf13/cobra"
+)
+
+// getOpts tracks headless get command options.
+type getOpts struct {
+	output string
+	wide   bool
+}
+
+func getCmd() *cobra.Command {
+	var opts getOpts
+
+	command := cobra.Command{
+		Use:     "get COMMAND",
+		Aliases: []string{"dump"},
+		Short:   "Print a resource table as seen in K9s",
+		Long:    "Print a resource table as seen in K9s using the same columns, custom views and aliases without launching the UI",
+		Example: `  k9s get pods -A
+  k9s get "dp kube-system /coredns" -o json
+  k9s get "po app=nginx @prod" -o csv`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return runGet(strings.Join(args, " "), opts)
+		},
+	}
+
+	command.Flags().StringVarP(
+		&opts.output,
+		"output", "o",
+		tableFormat,
+		"Output format. One of "+strings.Join(printFormats, "|"),
+	)
+	command.Flags().BoolVarP(
+		&opts.wide,
+		"wide", "w",
+		false,
+		"Include wide columns",
+	)
+	command.Flags().BoolVarP(
+		k9sFlags.AllNamespaces,
+		"all-namespaces", "A",
+		false,
+		"List resources across all namespaces",
+	)
+	command.Flags().StringVarP(
+		k8sFlags.Namespace,
+		"namespace", "n",
+		"",
+		"If present, the namespace scope for this CLI request",
+	)
+	command.Flags().StringVar(
+		k8sFlags.Context,
+		"context",
+		"",
+		"The name of the kubeconfig context to use",
+	)
+	command.Flags().StringVar(
+		k8sFlags.KubeConfig,
+		"kubeconfig",
+		"",
+		"Path to the kubeconfig file to use for CLI requests",
+	)
+	command.Flags().StringVar(
+		k9sFlags.Snapshot,
+		"snapshot",
+		"",
+		"Read resources from a cluster snapshot archive",
+	)
+
+	return &command
+}
+
+func runGet(line string, opts getOpts) error {
+	if err := config.InitLocs(); err != nil {
+		return err
+	}
+	logFile, err := initLogger()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = logFile.Close() }()
+
+	if *k9sFlags.Snapshot != "" {
+		stop, err := serveSnapshot(*k9sFlags.Snapshot)
+		if err != nil {
+			return err
+		}
+		defer stop()
+	}
+	p := icmd.NewInterpreter(line)
+	if ct, ok := p.HasContext(); ok {
+		*k8sFlags.Context = ct
+	}
+	cfg, err := loadHeadlessConfiguration()
+	if err != nil {
+		return err
+	}
+	f := watch.NewFactory(cfg.GetConnection())
+	ns := cfg.ActiveNamespace()
+	f.Start(ns)
+	defer f.Terminate()
+
+	data, err := headlessTable(f, cfg, p, ns)
+	if err != nil {
+		return err
+	}
+
+	return printTableData(out, data, printOpts{
+		format:     opts.output,
+		wide:       opts.wide,
+		hasMetrics: f.Client().HasMetrics(),
+	})
+}
+
+// headlessTable resolves a command line and renders the associated resource table.
+func headlessTable(f *watch.Factory, cfg *config.Config, p *icmd.Interpreter, ns string) (*model1.TableData, error) {
+	alias := dao.NewAlias(f)
+	if _, err := alias.Ensure(cfg.ContextAliasesPath()); err != nil {
+		return nil, err
+	}
+	cmd := p.Cmd()
+	gvr, ok := alias.Resolve(p)
+	if !ok {
+		return nil, fmt.Errorf("`%s` command not found", cmd)
+	}
+	meta, err := dao.MetaAccess.MetaFor(gvr)
+	if err != nil {
+		return nil, err
+	}
+	if cns, ok := p.NSArg(); ok {
+		ns = cns
+	}
+	if !meta.Namespaced {
+		ns = client.ClusterScope
+	}
+	ns = client.CleanseNamespace(ns)
+
+	views := config.NewCustomView()
+	if err := views.Load(config.AppViewsFile); err != nil {
+		slog.Warn("Custom views load failed", slogs.Error, err)
+	}
+	vs := views.ViewSettingFor(ns, append([]string{gvr.String(), p.GetLine(), cmd}, p.Aliases()...)...)
+
+	t := model.NewTable(gvr)
+	t.SetNamespace(ns)
+	sel, err := p.LabelsSelector()
+	if err != nil {
+		return nil, err
+	}
+	t.SetLabelSelector(sel)
+
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, f)
+	ctx = context.WithValue(ctx, internal.KeyWithMetrics, f.Client().HasMetrics())
+	t.SetViewSetting(context.Background(), vs)
+
+	// Prime the informers, wait for them to sync up and render for real.
+	if err := t.Refresh(ctx); err != nil {
+		slog.Debug("Priming refresh failed", slogs.GVR, gvr, slogs.Error, err)
+	}
+	f.WaitForCacheSync()
+	if err := t.Refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	data := t.Peek()
+	if q, ok := p.FilterArg(); ok {
+		data = data.Filter(model1.FilterOpts{Filter: q})
+	}
+	if q, ok := p.FuzzyArg(); ok {
+		data = data.Filter(model1.FilterOpts{Filter: "-f " + q})
+	}
+	data.Sort(data.ComputeSortCol(vs, model1.SortColumn{}, false))
+
+	return data, nil
+}
+
+func loadHeadlessConfiguration() (*config.Config, error) {
+	k8sCfg := client.NewConfig(k8sFlags)
+	k9sCfg := config.NewConfig(k8sCfg)
+
+	conn, err := client.InitConnection(k8sCfg, slog.Default())
+	if err != nil {
+		return nil, err
+	}
+	k9sCfg.SetConnection(conn)
+	if err := k9sCfg.Load(config.AppConfigFile, false); err != nil {
+		slog.Warn("Fail to load k9s configuration", slogs.Error, err)
+	}
+	k9sCfg.K9s.Override(k9sFlags)
+	if err := k9sCfg.Refine(k8sFlags, k9sFlags, k8sCfg); err != nil {
+		return nil, err
+	}
+	if !conn.ConnectionOK() {
+		return nil, errors.New("k8s connection failed for context: " + k9sCfg.K9s.ActiveContextName())
+	}
+
+	return k9sCfg, nil
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	tableFormat = "table"
+	csvFormat   = "csv"
+	jsonFormat  = "json"
+	yamlFormat  = "yaml"
+)
+
+var printFormats = []string{tableFormat, csvFormat, jsonFormat, yamlFormat}
+
+// printOpts tracks headless printing options.
+type printOpts struct {
+	format     string
+	wide       bool
+	hasMetrics bool
+}
+
+// printTableData writes out table data in the requested format.
+func printTableData(w io.Writer, data *model1.TableData, opts printOpts) error {
+	cols, idx := visibleCols(data, opts)
+	rows := make([][]string, 0, data.RowCount())
+	data.RowsRange(func(_ int, re model1.RowEvent) bool {
+		row := make([]string, 0, len(idx))
+		for _, i := range idx {
+			if i < len(re.Row.Fields) {
+				row = append(row, re.Row.Fields[i])
+			} else {
+				row = append(row, "")
+			}
+		}
+		rows = append(rows, row)
+		return true
+	})
+
+	switch strings.ToLower(opts.format) {
+	case "", tableFormat:
+		return printTable(w, cols, rows)
+	case csvFormat:
+		return printCSV(w, cols, rows)
+	case jsonFormat:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(toRecords(cols, rows))
+	case yamlFormat:
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(toRecords(cols, rows)); err != nil {
+			return err
+		}
+		return enc.Close()
+	default:
+		return fmt.Errorf("invalid output format %q. must be one of %s", opts.format, strings.Join(printFormats, "|"))
+	}
+}
+
+// visibleCols returns the column names and indices the TUI would display.
+func visibleCols(data *model1.TableData, opts printOpts) (names []string, indices []int) {
+	allNS := client.IsClusterWide(data.GetNamespace())
+	for i, h := range data.Header() {
+		switch {
+		case h.Hide, h.Wide && !opts.wide, h.VS:
+			continue
+		case h.MX && !opts.hasMetrics:
+			continue
+		case h.Name == "NAMESPACE" && !allNS:
+			continue
+		}
+		names, indices = append(names, h.Name), append(indices, i)
+	}
+
+	return
+}
+
+func printTable(w io.Writer, cols []string, rows [][]string) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprintln(tw, strings.Join(cols, "\t"))
+	for _, r := range rows {
+		_, _ = fmt.Fprintln(tw, strings.Join(r, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+func printCSV(w io.Writer, cols []string, rows [][]string) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write(cols)
+	for _, r := range rows {
+		_ = cw.Write(r)
+	}
+	cw.Flush()
+
+	return cw.Error()
+}
+
+func toRecords(cols []string, rows [][]string) []map[string]string {
+	rr := make([]map[string]string, 0, len(rows))
+	for _, r := range rows {
+		rec := make(map[string]string, len(cols))
+		for i, c := range cols {
+			rec[c] = r[i]
+		}
+		rr = append(rr, rec)
+	}
+
+	return rr
+}
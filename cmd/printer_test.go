@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_printTableData(t *testing.T) {
+	uu := map[string]struct {
+		ns   string
+		opts printOpts
+		e    string
+		err  string
+	}{
+		"table": {
+			ns:   "default",
+			opts: printOpts{format: tableFormat},
+			e:    "NAME   STATUS\np1     Running\np2     Pending\n",
+		},
+		"table-all-ns-wide-mx": {
+			ns:   client.NamespaceAll,
+			opts: printOpts{format: tableFormat, wide: true, hasMetrics: true},
+			e:    "NAMESPACE   NAME   STATUS    CPU   IP\nns1         p1     Running   10    1.1.1.1\nns2         p2     Pending   20    2.2.2.2\n",
+		},
+		"csv": {
+			ns:   "default",
+			opts: printOpts{format: csvFormat},
+			e:    "NAME,STATUS\np1,Running\np2,Pending\n",
+		},
+		"json": {
+			ns:   "default",
+			opts: printOpts{format: jsonFormat},
+			e:    "[\n  {\n    \"NAME\": \"p1\",\n    \"STATUS\": \"Running\"\n  },\n  {\n    \"NAME\": \"p2\",\n    \"STATUS\": \"Pending\"\n  }\n]\n",
+		},
+		"yaml": {
+			ns:   "default",
+			opts: printOpts{format: yamlFormat},
+			e:    "- NAME: p1\n  STATUS: Running\n- NAME: p2\n  STATUS: Pending\n",
+		},
+		"toast": {
+			opts: printOpts{format: "bozo"},
+			err:  `invalid output format "bozo". must be one of table|csv|json|yaml`,
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			var buff bytes.Buffer
+			err := printTableData(&buff, makeTableData(u.ns), u.opts)
+			if u.err != "" {
+				require.EqualError(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.e, buff.String())
+		})
+	}
+}
+
+func makeTableData(ns string) *model1.TableData {
+	h := model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "STATUS"},
+		model1.HeaderColumn{Name: "CPU", Attrs: model1.Attrs{MX: true}},
+		model1.HeaderColumn{Name: "IP", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Hide: true}},
+	}
+	re := model1.NewRowEvents(2)
+	re.Add(model1.NewRowEvent(model1.EventAdd, model1.Row{
+		ID:     "ns1/p1",
+		Fields: model1.Fields{"ns1", "p1", "Running", "10", "1.1.1.1", ""},
+	}))
+	re.Add(model1.NewRowEvent(model1.EventAdd, model1.Row{
+		ID:     "ns2/p2",
+		Fields: model1.Fields{"ns2", "p2", "Pending", "20", "2.2.2.2", ""},
+	}))
+
+	return model1.NewTableDataFull(client.PodGVR, ns, h, re)
+}
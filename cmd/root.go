@@ -61,9 +61,9 @@ func init() {
 		return flagError{err: err}
 	})
 
-	rootCmd.AddCommand(versionCmd(), infoCmd())
 	initK9sFlags()
 	initK8sFlags()
+	rootCmd.AddCommand(versionCmd(), infoCmd(), getCmd(), snapshotCmd(), scanCmd())
 }
 
 // Execute root command.
@@ -77,13 +77,9 @@ func run(*cobra.Command, []string) error {
 	if err := config.InitLocs(); err != nil {
 		return err
 	}
-	logFile, err := os.OpenFile(
-		*k9sFlags.LogFile,
-		os.O_CREATE|os.O_APPEND|os.O_WRONLY,
-		data.DefaultFileMod,
-	)
+	logFile, err := initLogger()
 	if err != nil {
-		return fmt.Errorf("log file %q init failed: %w", *k9sFlags.LogFile, err)
+		return err
 	}
 	defer func() {
 		if logFile != nil {
@@ -100,10 +96,13 @@ func run(*cobra.Command, []string) error {
 		}
 	}()
 
-	slog.SetDefault(slog.New(tint.NewHandler(logFile, &tint.Options{
-		Level:      parseLevel(*k9sFlags.LogLevel),
-		TimeFormat: time.RFC3339,
-	})))
+	if *k9sFlags.Snapshot != "" {
+		stop, err := serveSnapshot(*k9sFlags.Snapshot)
+		if err != nil {
+			return err
+		}
+		defer stop()
+	}
 
 	cfg, err := loadConfiguration()
 	if err != nil {
@@ -130,6 +129,23 @@ func run(*cobra.Command, []string) error {
 	return nil
 }
 
+func initLogger() (*os.File, error) {
+	logFile, err := os.OpenFile(
+		*k9sFlags.LogFile,
+		os.O_CREATE|os.O_APPEND|os.O_WRONLY,
+		data.DefaultFileMod,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("log file %q init failed: %w", *k9sFlags.LogFile, err)
+	}
+	slog.SetDefault(slog.New(tint.NewHandler(logFile, &tint.Options{
+		Level:      parseLevel(*k9sFlags.LogLevel),
+		TimeFormat: time.RFC3339,
+	})))
+
+	return logFile, nil
+}
+
 func loadConfiguration() (*config.Config, error) {
 	slog.Info("🐶 K9s starting up...")
 
@@ -270,6 +286,12 @@ func initK9sFlags() {
 		"",
 		"Sets a path to a dir for a screen dumps",
 	)
+	rootCmd.Flags().StringVar(
+		k9sFlags.Snapshot,
+		"snapshot",
+		"",
+		"Browse a cluster snapshot archive offline (read-only)",
+	)
 	rootCmd.Flags()
 }
 
@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/dao"
+	icmd "github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/k9s/internal/vul"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/spf13/cobra"
+)
+
+const scanTimeout = 30 * time.Minute
+
+// scanOpts tracks headless scan command options.
+type scanOpts struct {
+	output  string
+	formats string
+}
+
+func scanCmd() *cobra.Command {
+	var opts scanOpts
+
+	formats := make([]string, 0, len(vul.ExportFormats))
+	for _, f := range vul.ExportFormats {
+		formats = append(formats, string(f))
+	}
+	command := cobra.Command{
+		Use:   "scan [TYPE/NAME]",
+		Short: "Export image vulnerability scans",
+		Long:  "Scan container images for a resource, a namespace or the whole cluster and export SBOMs and vulnerability reports",
+		Example: `  k9s scan dp/nginx -n default -f cyclonedx,sarif
+  k9s scan -n kube-system -o audit
+  k9s scan -A -f spdx`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			var target string
+			if len(args) > 0 {
+				target = args[0]
+			}
+			return runScan(target, opts)
+		},
+	}
+
+	command.Flags().StringVarP(
+		&opts.formats,
+		"format", "f",
+		strings.Join(formats, ","),
+		"Comma separated export formats. Any of "+strings.Join(formats, "|"),
+	)
+	command.Flags().StringVarP(
+		&opts.output,
+		"output", "o",
+		"",
+		"Export directory. Defaults to scans-<context>-<timestamp>",
+	)
+	command.Flags().BoolVarP(
+		k9sFlags.AllNamespaces,
+		"all-namespaces", "A",
+		false,
+		"Scan images across all namespaces",
+	)
+	command.Flags().StringVarP(
+		k8sFlags.Namespace,
+		"namespace", "n",
+		"",
+		"If present, the namespace scope for this CLI request",
+	)
+	command.Flags().StringVar(
+		k8sFlags.Context,
+		"context",
+		"",
+		"The name of the kubeconfig context to use",
+	)
+	command.Flags().StringVar(
+		k8sFlags.KubeConfig,
+		"kubeconfig",
+		"",
+		"Path to the kubeconfig file to use for CLI requests",
+	)
+
+	return &command
+}
+
+func runScan(target string, opts scanOpts) error {
+	ff, err := vul.ParseExportFormats(opts.formats)
+	if err != nil {
+		return err
+	}
+	if err := config.InitLocs(); err != nil {
+		return err
+	}
+	logFile, err := initLogger()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = logFile.Close() }()
+
+	cfg, err := loadHeadlessConfiguration()
+	if err != nil {
+		return err
+	}
+	ns := client.CleanseNamespace(cfg.ActiveNamespace())
+	f := watch.NewFactory(cfg.GetConnection())
+	f.Start(ns)
+	defer f.Terminate()
+
+	vul.ImgScanner = vul.NewImageScanner(cfg.K9s.ImageScans, slog.Default())
+	vul.ImgScanner.Init("k9s", version)
+	defer vul.ImgScanner.Stop()
+	if !vul.ImgScanner.IsInitialized() {
+		return errors.New("vulnerability database initialization failed. Check the logs")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), scanTimeout)
+	defer cancel()
+	ii, err := scanImages(ctx, f, cfg, target, ns)
+	if err != nil {
+		return err
+	}
+	if len(ii) == 0 {
+		return errors.New("no images found")
+	}
+	output := opts.output
+	if output == "" {
+		output = data.SanitizeFileName(fmt.Sprintf("scans-%s-%d", cfg.K9s.ActiveContextName(), time.Now().Unix()))
+	}
+	files, err := vul.ImgScanner.Export(ctx, output, ii, ff...)
+	for _, file := range files {
+		_, _ = fmt.Fprintln(out, file)
+	}
+
+	return err
+}
+
+// scanImages lists the images for a given TYPE/NAME target or for all pods in
+// the namespace when no target is given.
+func scanImages(ctx context.Context, f *watch.Factory, cfg *config.Config, target, ns string) ([]string, error) {
+	if target == "" {
+		return dao.NamespaceImages(f, ns)
+	}
+
+	res, name, err := parseScanTarget(target)
+	if err != nil {
+		return nil, err
+	}
+	alias := dao.NewAlias(f)
+	if _, err := alias.Ensure(cfg.ContextAliasesPath()); err != nil {
+		return nil, err
+	}
+	gvr, ok := alias.Resolve(icmd.NewInterpreter(res))
+	if !ok {
+		return nil, fmt.Errorf("`%s` resource not found", res)
+	}
+	if ns == client.BlankNamespace {
+		ns = client.DefaultNamespace
+	}
+
+	return dao.ListImages(ctx, f, gvr, client.FQN(ns, name))
+}
+
+func parseScanTarget(s string) (string, string, error) {
+	res, name, ok := strings.Cut(s, "/")
+	if !ok || res == "" || name == "" {
+		return "", "", fmt.Errorf("invalid scan target %q. Expecting TYPE/NAME", s)
+	}
+
+	return res, name, nil
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseScanTarget(t *testing.T) {
+	uu := map[string]struct {
+		target    string
+		res, name string
+		err       string
+	}{
+		"plain": {
+			target: "dp/nginx",
+			res:    "dp",
+			name:   "nginx",
+		},
+		"no-name": {
+			target: "dp/",
+			err:    `invalid scan target "dp/". Expecting TYPE/NAME`,
+		},
+		"no-type": {
+			target: "nginx",
+			err:    `invalid scan target "nginx". Expecting TYPE/NAME`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			res, name, err := parseScanTarget(u.target)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.res, res)
+			assert.Equal(t, u.name, name)
+		})
+	}
+}
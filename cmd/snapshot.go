@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/k9s/internal/snapshot"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/spf13/cobra"
+)
+
+func snapshotCmd() *cobra.Command {
+	var output string
+
+	command := cobra.Command{
+		Use:   "snapshot",
+		Short: "Capture a cluster snapshot",
+		Long:  "Capture resources, events, logs tail and metrics into an archive that can be browsed offline via k9s --snapshot",
+		Example: `  k9s snapshot -n kube-system
+  k9s snapshot -A -o incident-42.tgz
+  k9s --snapshot incident-42.tgz`,
+		Args: cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			return runSnapshot(output)
+		},
+	}
+
+	command.Flags().StringVarP(
+		&output,
+		"output", "o",
+		"",
+		"Path of the snapshot archive. Defaults to snapshot-<context>-<timestamp>.tgz",
+	)
+	command.Flags().BoolVarP(
+		k9sFlags.AllNamespaces,
+		"all-namespaces", "A",
+		false,
+		"Capture resources across all namespaces",
+	)
+	command.Flags().StringVarP(
+		k8sFlags.Namespace,
+		"namespace", "n",
+		"",
+		"If present, the namespace scope for this CLI request",
+	)
+	command.Flags().StringVar(
+		k8sFlags.Context,
+		"context",
+		"",
+		"The name of the kubeconfig context to use",
+	)
+	command.Flags().StringVar(
+		k8sFlags.KubeConfig,
+		"kubeconfig",
+		"",
+		"Path to the kubeconfig file to use for CLI requests",
+	)
+
+	return &command
+}
+
+func runSnapshot(output string) error {
+	if err := config.InitLocs(); err != nil {
+		return err
+	}
+	logFile, err := initLogger()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = logFile.Close() }()
+
+	cfg, err := loadHeadlessConfiguration()
+	if err != nil {
+		return err
+	}
+	f := watch.NewFactory(cfg.GetConnection())
+	a, err := f.Snapshot(context.Background(), cfg.ActiveNamespace())
+	if err != nil {
+		return err
+	}
+	if output == "" {
+		output = data.SanitizeFileName(a.FileName())
+	}
+	if err := a.Save(output); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(out, "Snapshot saved to %s\n", output)
+
+	return nil
+}
+
+// serveSnapshot serves a snapshot archive and points the k8s flags at it.
+func serveSnapshot(path string) (func(), error) {
+	a, err := snapshot.Load(path)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := os.MkdirTemp("", "k9s-snapshot-")
+	if err != nil {
+		return nil, err
+	}
+	srv := snapshot.NewServer(a)
+	if _, err := srv.Start(); err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, err
+	}
+	stop := func() {
+		if err := srv.Stop(); err != nil {
+			slog.Warn("Snapshot server stop failed", slogs.Error, err)
+		}
+		_ = os.RemoveAll(dir)
+	}
+
+	kubeConfig := filepath.Join(dir, "config")
+	if err := srv.WriteKubeConfig(kubeConfig); err != nil {
+		stop()
+		return nil, err
+	}
+	// Keep discovery caches for the ephemeral server away from the user's.
+	if err := os.Setenv("KUBECACHEDIR", filepath.Join(dir, "cache")); err != nil {
+		stop()
+		return nil, err
+	}
+	*k8sFlags.KubeConfig, *k8sFlags.Context = kubeConfig, a.Manifest.Context
+	*k9sFlags.ReadOnly = true
+	slog.Info("Serving snapshot",
+		slogs.Path, path,
+		slogs.Context, a.Manifest.Context,
+	)
+
+	return stop, nil
+}
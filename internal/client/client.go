@@ -31,6 +31,7 @@ const (
 	cacheSize     = 100
 	cacheExpiry   = 5 * time.Minute
 	cacheMXAPIKey = "metricsAPI"
+	cacheMXPrvKey = "metricsProvider"
 	serverVersion = "serverVersion"
 	cacheNSKey    = "validNamespaces"
 )
@@ -348,6 +349,10 @@ func (a *APIClient) Config() *Config {
 
 // HasMetrics checks if the cluster supports metrics.
 func (a *APIClient) HasMetrics() bool {
+	if HasMetricsProvider() {
+		return a.supportsMetricsProvider() == nil
+	}
+
 	return a.supportsMetricsResources() == nil
 }
 
@@ -644,6 +649,40 @@ func (a *APIClient) supportsMetricsResources() error {
 	return metricsUnsupportedErr
 }
 
+func (a *APIClient) supportsMetricsProvider() error {
+	supported, ok := a.checkCacheBool(cacheMXPrvKey)
+	if ok {
+		if supported {
+			return nil
+		}
+		return noMetricServerErr
+	}
+
+	defer func() {
+		a.cache.Add(cacheMXPrvKey, supported, cacheExpiry)
+	}()
+
+	p := DialMetrics(a).provider
+	if p == nil {
+		// Provider init failed, metrics are served by metrics-server.
+		err := a.supportsMetricsResources()
+		supported = err == nil
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), a.config.CallTimeout())
+	defer cancel()
+	if err := p.Ping(ctx); err != nil {
+		slog.Warn("Metrics provider unreachable",
+			slogs.Subsys, p.Name(),
+			slogs.Error, err,
+		)
+		return err
+	}
+	supported = true
+
+	return nil
+}
+
 func checkMetricsVersion(grp *metav1.APIGroup) bool {
 	for _, v := range grp.Versions {
 		for _, supportedVersion := range supportedMetricsAPIVersions {
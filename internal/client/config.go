@@ -110,6 +110,18 @@ func (c *Config) SwitchContext(name string) error {
 	return nil
 }
 
+// ContextConfig returns a new configuration bound to the given context.
+// The current configuration remains unchanged.
+func (c *Config) ContextConfig(name string) (*Config, error) {
+	cfg := NewConfig(c.flags)
+	cfg.proxy = c.proxy
+	if err := cfg.SwitchContext(name); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
 func (c *Config) Clone(ns string) (*genericclioptions.ConfigFlags, error) {
 	flags := genericclioptions.NewConfigFlags(false)
 	ct, err := c.CurrentContextName()
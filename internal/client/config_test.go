@@ -214,6 +214,28 @@ func TestConfigSwitchContext(t *testing.T) {
 	assert.Equal(t, "blee", ctx)
 }
 
+func TestConfigContextConfig(t *testing.T) {
+	cluster := "duh"
+	flags := genericclioptions.ConfigFlags{
+		KubeConfig: &kubeConfig,
+		Context:    &cluster,
+	}
+
+	cfg := client.NewConfig(&flags)
+	cfg1, err := cfg.ContextConfig("blee")
+	require.NoError(t, err)
+
+	ctx, err := cfg1.CurrentContextName()
+	require.NoError(t, err)
+	assert.Equal(t, "blee", ctx)
+	ctx, err = cfg.CurrentContextName()
+	require.NoError(t, err)
+	assert.Equal(t, "duh", ctx)
+
+	_, err = cfg.ContextConfig("zorg")
+	require.Error(t, err)
+}
+
 func TestConfigAccess(t *testing.T) {
 	context := "duh"
 	flags := genericclioptions.ConfigFlags{
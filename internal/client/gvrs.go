@@ -58,8 +58,12 @@ var (
 	RefGVR = NewGVR("references")
 	PuGVR  = NewGVR("pulses")
 	ScnGVR = NewGVR("scans")
+	VdbGVR = NewGVR("vuldbs")
 	DirGVR = NewGVR("dirs")
+	LpGVR  = NewGVR("logpatterns")
+	AlGVR  = NewGVR("alerts")
 	PfGVR  = NewGVR("portforwards")
+	PfpGVR = NewGVR("pf-profiles")
 	SdGVR  = NewGVR("screendumps")
 	BeGVR  = NewGVR("benchmarks")
 	AliGVR = NewGVR("aliases")
@@ -91,8 +95,12 @@ var reservedGVRs = sets.New(
 	RefGVR,
 	PuGVR,
 	ScnGVR,
+	VdbGVR,
 	DirGVR,
+	LpGVR,
+	AlGVR,
 	PfGVR,
+	PfpGVR,
 	SdGVR,
 	BeGVR,
 	AliGVR,
@@ -7,10 +7,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"math"
 	"strconv"
 	"time"
 
+	"github.com/derailed/k9s/internal/slogs"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/cache"
@@ -30,6 +32,13 @@ var MetricsDial *MetricsServer
 func DialMetrics(c Connection) *MetricsServer {
 	if MetricsDial == nil {
 		MetricsDial = NewMetricsServer(c)
+		if fn := metricsProviderFn(); fn != nil {
+			if p, err := fn(c); err != nil {
+				slog.Warn("Metrics provider init failed. Falling back to metrics-server", slogs.Error, err)
+			} else {
+				MetricsDial.provider = p
+			}
+		}
 	}
 
 	return MetricsDial
@@ -44,7 +53,8 @@ func ResetMetrics() {
 type MetricsServer struct {
 	Connection
 
-	cache *cache.LRUExpireCache
+	cache    *cache.LRUExpireCache
+	provider MetricsProvider
 }
 
 // NewMetricsServer return a metric server instance.
@@ -87,7 +97,19 @@ func (*MetricsServer) ClusterLoad(nos *v1.NodeList, nmx *mv1beta1.NodeMetricsLis
 	return nil
 }
 
+// ProviderName returns the active metrics provider name.
+func (m *MetricsServer) ProviderName() string {
+	if m.provider == nil {
+		return MetricsServerProviderName
+	}
+
+	return m.provider.Name()
+}
+
 func (m *MetricsServer) checkAccess(ns string, gvr *GVR, msg string) error {
+	if m.provider != nil {
+		return nil
+	}
 	if !m.HasMetrics() {
 		return errors.New("no metrics-server detected on cluster")
 	}
@@ -165,6 +187,15 @@ func (m *MetricsServer) FetchNodesMetrics(ctx context.Context) (*mv1beta1.NodeMe
 		return mxList, nil
 	}
 
+	if m.provider != nil {
+		mxList, err := m.provider.FetchNodesMetrics(ctx)
+		if err != nil {
+			return mx, err
+		}
+		m.cache.Add(key, mxList, mxCacheExpiry)
+		return mxList, nil
+	}
+
 	client, err := m.MXDial()
 	if err != nil {
 		return mx, err
@@ -247,6 +278,15 @@ func (m *MetricsServer) FetchPodsMetrics(ctx context.Context, ns string) (*mv1be
 		return mxList, nil
 	}
 
+	if m.provider != nil {
+		mxList, err := m.provider.FetchPodsMetrics(ctx, ns)
+		if err != nil {
+			return mx, err
+		}
+		m.cache.Add(key, mxList, mxCacheExpiry)
+		return mxList, nil
+	}
+
 	client, err := m.MXDial()
 	if err != nil {
 		return mx, err
@@ -271,6 +311,33 @@ func (m *MetricsServer) FetchPodsMetrics(ctx context.Context, ns string) (*mv1be
 	return mxList, nil
 }
 
+// FetchPodsExtMetricsMap returns extra pods series ie network i/o and restarts rate.
+// Only available when an alternate metrics provider is configured.
+func (m *MetricsServer) FetchPodsExtMetricsMap(ctx context.Context, ns string) (PodsExtMetricsMap, error) {
+	if m.provider == nil {
+		return nil, errors.New("no metrics provider configured for extra pod metrics")
+	}
+	if ns == NamespaceAll {
+		ns = BlankNamespace
+	}
+
+	key := FQN(ns, "pods-ext")
+	if entry, ok := m.cache.Get(key); ok {
+		mm, ok := entry.(PodsExtMetricsMap)
+		if !ok {
+			return nil, fmt.Errorf("expected PodsExtMetricsMap but got %T", entry)
+		}
+		return mm, nil
+	}
+	mm, err := m.provider.FetchPodsExtMetrics(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+	m.cache.Add(key, mm, mxCacheExpiry)
+
+	return mm, nil
+}
+
 // FetchContainersMetrics returns a pod's containers metrics.
 func (m *MetricsServer) FetchContainersMetrics(ctx context.Context, fqn string) (ContainersMetrics, error) {
 	mm, err := m.FetchPodMetrics(ctx, fqn)
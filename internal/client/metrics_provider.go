@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"context"
+	"sync"
+
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+// MetricsServerProviderName represents the default metrics.k8s.io provider.
+const MetricsServerProviderName = "metrics-server"
+
+// MetricsProvider represents an alternate source for nodes and pods metrics.
+type MetricsProvider interface {
+	// Name returns the provider name.
+	Name() string
+
+	// Ping checks if the provider is reachable.
+	Ping(ctx context.Context) error
+
+	// FetchNodesMetrics returns all nodes usage metrics.
+	FetchNodesMetrics(ctx context.Context) (*mv1beta1.NodeMetricsList, error)
+
+	// FetchPodsMetrics returns pods usage metrics in a given namespace.
+	FetchPodsMetrics(ctx context.Context, ns string) (*mv1beta1.PodMetricsList, error)
+
+	// FetchPodsExtMetrics returns extra pods series in a given namespace.
+	FetchPodsExtMetrics(ctx context.Context, ns string) (PodsExtMetricsMap, error)
+}
+
+// MetricsProviderFn builds a metrics provider for a given connection.
+type MetricsProviderFn func(Connection) (MetricsProvider, error)
+
+var (
+	mxProviderFn MetricsProviderFn
+	mxProviderMx sync.RWMutex
+)
+
+// SetMetricsProvider sets the active metrics provider. A nil provider
+// falls back to metrics-server.
+func SetMetricsProvider(fn MetricsProviderFn) {
+	mxProviderMx.Lock()
+	mxProviderFn = fn
+	mxProviderMx.Unlock()
+
+	ResetMetrics()
+}
+
+// HasMetricsProvider checks if an alternate metrics provider is configured.
+func HasMetricsProvider() bool {
+	return metricsProviderFn() != nil
+}
+
+func metricsProviderFn() MetricsProviderFn {
+	mxProviderMx.RLock()
+	defer mxProviderMx.RUnlock()
+
+	return mxProviderFn
+}
@@ -0,0 +1,419 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	restclient "k8s.io/client-go/rest"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+const (
+	// PrometheusProviderName represents the prometheus metrics provider.
+	PrometheusProviderName = "prometheus"
+
+	// DefaultPrometheusTimeout represents the default prometheus query timeout.
+	DefaultPrometheusTimeout = 10 * time.Second
+
+	promQueryPath = "/api/v1/query"
+	promPingQuery = "vector(1)"
+
+	// promNamespaceVar represents a pod queries placeholder expanded to a namespace label matcher.
+	promNamespaceVar = "$namespace"
+)
+
+// PrometheusQueries tracks the PromQL queries used to gather metrics.
+// Cpu queries must yield cores and memory queries bytes. Pod queries may use
+// the $namespace placeholder to only fetch series from the viewed namespace.
+type PrometheusQueries struct {
+	NodeCPU, NodeMEM   string
+	PodCPU, PodMEM     string
+	PodNetRx, PodNetTx string
+	PodRestarts        string
+}
+
+// DefaultPrometheusQueries tracks cAdvisor and kube-state-metrics based queries.
+var DefaultPrometheusQueries = PrometheusQueries{
+	NodeCPU:     `sum by (node) (rate(container_cpu_usage_seconds_total{id="/"}[5m]))`,
+	NodeMEM:     `sum by (node) (container_memory_working_set_bytes{id="/"})`,
+	PodCPU:      `sum by (namespace, pod, container) (rate(container_cpu_usage_seconds_total{container!="",container!="POD",$namespace}[5m]))`,
+	PodMEM:      `sum by (namespace, pod, container) (container_memory_working_set_bytes{container!="",container!="POD",$namespace})`,
+	PodNetRx:    `sum by (namespace, pod) (rate(container_network_receive_bytes_total{$namespace}[5m]))`,
+	PodNetTx:    `sum by (namespace, pod) (rate(container_network_transmit_bytes_total{$namespace}[5m]))`,
+	PodRestarts: `sum by (namespace, pod) (increase(kube_pod_container_status_restarts_total{$namespace}[1h]))`,
+}
+
+func (q PrometheusQueries) withDefaults() PrometheusQueries {
+	d := DefaultPrometheusQueries
+	for _, f := range []struct{ v, d *string }{
+		{&q.NodeCPU, &d.NodeCPU},
+		{&q.NodeMEM, &d.NodeMEM},
+		{&q.PodCPU, &d.PodCPU},
+		{&q.PodMEM, &d.PodMEM},
+		{&q.PodNetRx, &d.PodNetRx},
+		{&q.PodNetTx, &d.PodNetTx},
+		{&q.PodRestarts, &d.PodRestarts},
+	} {
+		if *f.v == "" {
+			*f.v = *f.d
+		}
+	}
+
+	return q
+}
+
+// PrometheusOptions tracks prometheus connection settings.
+type PrometheusOptions struct {
+	// Address represents a prometheus server url.
+	Address string
+
+	// Service represents a ns/name:port prometheus service reached via the api server proxy.
+	Service string
+
+	// BearerToken authenticates direct address queries.
+	BearerToken string
+
+	// InsecureSkipVerify skips tls verification for direct address queries.
+	InsecureSkipVerify bool
+
+	// Timeout represents a query timeout.
+	Timeout time.Duration
+
+	// Queries overrides the default PromQL queries.
+	Queries PrometheusQueries
+}
+
+// Prometheus serves nodes and pods metrics from a prometheus server.
+type Prometheus struct {
+	opts PrometheusOptions
+	base string
+	http *http.Client
+}
+
+var _ MetricsProvider = (*Prometheus)(nil)
+
+// NewPrometheus returns a new prometheus metrics provider.
+func NewPrometheus(c Connection, opts PrometheusOptions) (*Prometheus, error) {
+	p := Prometheus{opts: opts}
+	p.opts.Queries = opts.Queries.withDefaults()
+	if p.opts.Timeout <= 0 {
+		p.opts.Timeout = DefaultPrometheusTimeout
+	}
+
+	switch {
+	case opts.Service != "":
+		ns, n := Namespaced(opts.Service)
+		if ns == "" || n == "" {
+			return nil, fmt.Errorf("invalid prometheus service %q. Expecting ns/name:port", opts.Service)
+		}
+		if c == nil {
+			return nil, errors.New("no connection available to proxy prometheus service")
+		}
+		cfg, err := c.RestConfig()
+		if err != nil {
+			return nil, err
+		}
+		hc, err := restclient.HTTPClientFor(cfg)
+		if err != nil {
+			return nil, err
+		}
+		hc.Timeout = p.opts.Timeout
+		p.http = hc
+		p.base = strings.TrimSuffix(cfg.Host, "/") + "/api/v1/namespaces/" + ns + "/services/" + n + "/proxy"
+	case opts.Address != "":
+		if _, err := url.Parse(opts.Address); err != nil {
+			return nil, fmt.Errorf("invalid prometheus address %q: %w", opts.Address, err)
+		}
+		tr := http.DefaultTransport.(*http.Transport).Clone()
+		if opts.InsecureSkipVerify {
+			tr.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec
+		}
+		p.http = &http.Client{Transport: tr, Timeout: p.opts.Timeout}
+		p.base = strings.TrimSuffix(opts.Address, "/")
+	default:
+		return nil, errors.New("prometheus address or service must be specified")
+	}
+
+	return &p, nil
+}
+
+// Name returns the provider name.
+func (*Prometheus) Name() string {
+	return PrometheusProviderName
+}
+
+// Ping checks if the prometheus server is reachable.
+func (p *Prometheus) Ping(ctx context.Context) error {
+	_, err := p.query(ctx, promPingQuery)
+
+	return err
+}
+
+// FetchNodesMetrics returns all nodes usage metrics.
+func (p *Prometheus) FetchNodesMetrics(ctx context.Context) (*mv1beta1.NodeMetricsList, error) {
+	cpu, err := p.query(ctx, p.opts.Queries.NodeCPU)
+	if err != nil {
+		return nil, err
+	}
+	mem, err := p.query(ctx, p.opts.Queries.NodeMEM)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := make(map[string]v1.ResourceList, len(cpu))
+	for _, s := range cpu {
+		n := s.nodeName()
+		if n == "" {
+			continue
+		}
+		rl := ensureResourceList(usage, n)
+		rl[v1.ResourceCPU] = toCPUQty(s.value)
+	}
+	for _, s := range mem {
+		n := s.nodeName()
+		if n == "" {
+			continue
+		}
+		rl := ensureResourceList(usage, n)
+		rl[v1.ResourceMemory] = toMEMQty(s.value)
+	}
+
+	now := metav1.Now()
+	mx := mv1beta1.NodeMetricsList{Items: make([]mv1beta1.NodeMetrics, 0, len(usage))}
+	for _, n := range sortedKeys(usage) {
+		mx.Items = append(mx.Items, mv1beta1.NodeMetrics{
+			ObjectMeta: metav1.ObjectMeta{Name: n},
+			Timestamp:  now,
+			Usage:      usage[n],
+		})
+	}
+
+	return &mx, nil
+}
+
+// FetchPodsMetrics returns pods usage metrics in a given namespace.
+func (p *Prometheus) FetchPodsMetrics(ctx context.Context, ns string) (*mv1beta1.PodMetricsList, error) {
+	cpu, err := p.query(ctx, nsQuery(p.opts.Queries.PodCPU, ns))
+	if err != nil {
+		return nil, err
+	}
+	mem, err := p.query(ctx, nsQuery(p.opts.Queries.PodMEM, ns))
+	if err != nil {
+		return nil, err
+	}
+
+	pods := make(map[string]map[string]v1.ResourceList)
+	add := func(ss []promSample, res v1.ResourceName, qty func(float64) resource.Quantity) {
+		for _, s := range ss {
+			fqn, ok := s.podFQN(ns)
+			if !ok {
+				continue
+			}
+			cos, ok := pods[fqn]
+			if !ok {
+				cos = make(map[string]v1.ResourceList)
+				pods[fqn] = cos
+			}
+			rl := ensureResourceList(cos, s.metric["container"])
+			rl[res] = qty(s.value)
+		}
+	}
+	add(cpu, v1.ResourceCPU, toCPUQty)
+	add(mem, v1.ResourceMemory, toMEMQty)
+
+	now := metav1.Now()
+	mx := mv1beta1.PodMetricsList{Items: make([]mv1beta1.PodMetrics, 0, len(pods))}
+	for _, fqn := range sortedKeys(pods) {
+		pns, n := Namespaced(fqn)
+		pmx := mv1beta1.PodMetrics{
+			ObjectMeta: metav1.ObjectMeta{Namespace: pns, Name: n},
+			Timestamp:  now,
+		}
+		cos := pods[fqn]
+		for _, co := range sortedKeys(cos) {
+			pmx.Containers = append(pmx.Containers, mv1beta1.ContainerMetrics{
+				Name:  co,
+				Usage: cos[co],
+			})
+		}
+		mx.Items = append(mx.Items, pmx)
+	}
+
+	return &mx, nil
+}
+
+// FetchPodsExtMetrics returns pods network i/o and restarts rate in a given namespace.
+func (p *Prometheus) FetchPodsExtMetrics(ctx context.Context, ns string) (PodsExtMetricsMap, error) {
+	mm := make(PodsExtMetricsMap)
+	for _, q := range []struct {
+		query string
+		set   func(*PodExtMetrics, float64)
+	}{
+		{p.opts.Queries.PodNetRx, func(m *PodExtMetrics, v float64) { m.NetRx = v }},
+		{p.opts.Queries.PodNetTx, func(m *PodExtMetrics, v float64) { m.NetTx = v }},
+		{p.opts.Queries.PodRestarts, func(m *PodExtMetrics, v float64) { m.RestartsRate = v }},
+	} {
+		ss, err := p.query(ctx, nsQuery(q.query, ns))
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range ss {
+			fqn, ok := s.podFQN(ns)
+			if !ok {
+				continue
+			}
+			m, ok := mm[fqn]
+			if !ok {
+				m = new(PodExtMetrics)
+				mm[fqn] = m
+			}
+			q.set(m, s.value)
+		}
+	}
+
+	return mm, nil
+}
+
+// nsQuery scopes a pod query to a given namespace by expanding its namespace placeholder.
+func nsQuery(q, ns string) string {
+	m := `namespace!=""`
+	if IsNamespaced(ns) {
+		m = `namespace="` + ns + `"`
+	}
+
+	return strings.ReplaceAll(q, promNamespaceVar, m)
+}
+
+type promSample struct {
+	metric map[string]string
+	value  float64
+}
+
+func (s promSample) nodeName() string {
+	if n := s.metric["node"]; n != "" {
+		return n
+	}
+	n := s.metric["instance"]
+	if h, _, err := net.SplitHostPort(n); err == nil {
+		return h
+	}
+
+	return n
+}
+
+// podFQN returns the sample pod fqn. Samples outside a given namespace are
+// skipped should a custom query not be scoped to it.
+func (s promSample) podFQN(ns string) (string, bool) {
+	pns, n := s.metric["namespace"], s.metric["pod"]
+	if pns == "" || n == "" {
+		return "", false
+	}
+	if IsNamespaced(ns) && ns != pns {
+		return "", false
+	}
+
+	return FQN(pns, n), true
+}
+
+type promResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+	Data      struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Metric map[string]string `json:"metric"`
+			Value  []any             `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+func (p *Prometheus) query(ctx context.Context, q string) ([]promSample, error) {
+	u := p.base + promQueryPath + "?" + url.Values{"query": {q}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	if p.opts.Service == "" && p.opts.BearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+p.opts.BearerToken)
+	}
+	resp, err := p.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("prometheus query failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var pr promResponse
+	if err := json.NewDecoder(resp.Body).Decode(&pr); err != nil {
+		return nil, fmt.Errorf("prometheus response decode failed (%s): %w", resp.Status, err)
+	}
+	if pr.Status != "success" {
+		return nil, fmt.Errorf("prometheus query %q failed: %s %s", q, pr.ErrorType, pr.Error)
+	}
+	if pr.Data.ResultType != "vector" {
+		return nil, fmt.Errorf("prometheus query %q must yield a vector but got %q", q, pr.Data.ResultType)
+	}
+
+	ss := make([]promSample, 0, len(pr.Data.Result))
+	for _, r := range pr.Data.Result {
+		if len(r.Value) != 2 {
+			continue
+		}
+		raw, ok := r.Value[1].(string)
+		if !ok {
+			continue
+		}
+		v, err := strconv.ParseFloat(raw, 64)
+		if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+			continue
+		}
+		ss = append(ss, promSample{metric: r.Metric, value: v})
+	}
+
+	return ss, nil
+}
+
+func ensureResourceList(m map[string]v1.ResourceList, k string) v1.ResourceList {
+	rl, ok := m[k]
+	if !ok {
+		rl = make(v1.ResourceList, 2)
+		m[k] = rl
+	}
+
+	return rl
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		kk = append(kk, k)
+	}
+	slices.Sort(kk)
+
+	return kk
+}
+
+func toCPUQty(cores float64) resource.Quantity {
+	return *resource.NewMilliQuantity(int64(math.Round(cores*1000)), resource.DecimalSI)
+}
+
+func toMEMQty(bytes float64) resource.Quantity {
+	return *resource.NewQuantity(int64(bytes), resource.BinarySI)
+}
@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPromServer(t *testing.T, results map[string]string) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/query", r.URL.Path)
+		q := r.URL.Query().Get("query")
+		if q == "boom" {
+			_, _ = fmt.Fprint(w, `{"status":"error","errorType":"bad_data","error":"parse error"}`)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"status":"success","data":{"resultType":"vector","result":[%s]}}`, results[q])
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func promSample(labels, v string) string {
+	return fmt.Sprintf(`{"metric":{%s},"value":[1700000000.1,%q]}`, labels, v)
+}
+
+func TestPrometheusNodesMetrics(t *testing.T) {
+	srv := newPromServer(t, map[string]string{
+		"cpu": strings.Join([]string{
+			promSample(`"node":"n1"`, "1.5"),
+			promSample(`"instance":"n2:10250"`, "0.25"),
+		}, ","),
+		"mem": promSample(`"node":"n1"`, "1048576"),
+	})
+
+	p, err := client.NewPrometheus(nil, client.PrometheusOptions{
+		Address: srv.URL,
+		Queries: client.PrometheusQueries{NodeCPU: "cpu", NodeMEM: "mem"},
+	})
+	require.NoError(t, err)
+
+	mx, err := p.FetchNodesMetrics(context.Background())
+	require.NoError(t, err)
+	require.Len(t, mx.Items, 2)
+	assert.Equal(t, "n1", mx.Items[0].Name)
+	assert.Equal(t, int64(1500), mx.Items[0].Usage.Cpu().MilliValue())
+	assert.Equal(t, int64(1048576), mx.Items[0].Usage.Memory().Value())
+	assert.Equal(t, "n2", mx.Items[1].Name)
+	assert.Equal(t, int64(250), mx.Items[1].Usage.Cpu().MilliValue())
+}
+
+func TestPrometheusPodsMetrics(t *testing.T) {
+	srv := newPromServer(t, map[string]string{
+		"cpu": strings.Join([]string{
+			promSample(`"namespace":"ns1","pod":"p1","container":"c1"`, "0.1"),
+			promSample(`"namespace":"ns1","pod":"p1","container":"c2"`, "0.2"),
+			promSample(`"namespace":"ns2","pod":"p2","container":"c1"`, "1"),
+			promSample(`"namespace":"ns1","pod":"p3","container":"c1"`, "NaN"),
+		}, ","),
+		"mem": promSample(`"namespace":"ns1","pod":"p1","container":"c1"`, "2097152"),
+		"rx":  promSample(`"namespace":"ns1","pod":"p1"`, "512"),
+		"tx":  promSample(`"namespace":"ns2","pod":"p2"`, "256"),
+		"rs":  promSample(`"namespace":"ns1","pod":"p1"`, "3"),
+	})
+
+	p, err := client.NewPrometheus(nil, client.PrometheusOptions{
+		Address: srv.URL,
+		Queries: client.PrometheusQueries{
+			PodCPU:      "cpu",
+			PodMEM:      "mem",
+			PodNetRx:    "rx",
+			PodNetTx:    "tx",
+			PodRestarts: "rs",
+		},
+	})
+	require.NoError(t, err)
+
+	mx, err := p.FetchPodsMetrics(context.Background(), "ns1")
+	require.NoError(t, err)
+	require.Len(t, mx.Items, 1)
+	assert.Equal(t, "ns1", mx.Items[0].Namespace)
+	assert.Equal(t, "p1", mx.Items[0].Name)
+	require.Len(t, mx.Items[0].Containers, 2)
+	assert.Equal(t, "c1", mx.Items[0].Containers[0].Name)
+	assert.Equal(t, int64(100), mx.Items[0].Containers[0].Usage.Cpu().MilliValue())
+	assert.Equal(t, int64(2097152), mx.Items[0].Containers[0].Usage.Memory().Value())
+
+	mx, err = p.FetchPodsMetrics(context.Background(), client.BlankNamespace)
+	require.NoError(t, err)
+	assert.Len(t, mx.Items, 2)
+
+	ext, err := p.FetchPodsExtMetrics(context.Background(), client.BlankNamespace)
+	require.NoError(t, err)
+	assert.Equal(t, &client.PodExtMetrics{NetRx: 512, RestartsRate: 3}, ext["ns1/p1"])
+	assert.Equal(t, &client.PodExtMetrics{NetTx: 256}, ext["ns2/p2"])
+}
+
+func TestPrometheusQueryFailed(t *testing.T) {
+	srv := newPromServer(t, nil)
+
+	p, err := client.NewPrometheus(nil, client.PrometheusOptions{
+		Address: srv.URL,
+		Queries: client.PrometheusQueries{NodeCPU: "boom"},
+	})
+	require.NoError(t, err)
+
+	_, err = p.FetchNodesMetrics(context.Background())
+	assert.ErrorContains(t, err, "parse error")
+}
+
+func TestNewPrometheusInvalid(t *testing.T) {
+	_, err := client.NewPrometheus(nil, client.PrometheusOptions{})
+	require.Error(t, err)
+
+	_, err = client.NewPrometheus(nil, client.PrometheusOptions{Service: "prometheus:9090"})
+	require.Error(t, err)
+}
+
+func TestPrometheusPing(t *testing.T) {
+	srv := newPromServer(t, nil)
+
+	p, err := client.NewPrometheus(nil, client.PrometheusOptions{Address: srv.URL})
+	require.NoError(t, err)
+	require.NoError(t, p.Ping(context.Background()))
+
+	srv.Close()
+	require.Error(t, p.Ping(context.Background()))
+}
+
+func TestPrometheusPodsMetricsNamespaced(t *testing.T) {
+	var qq []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		qq = append(qq, r.URL.Query().Get("query"))
+		_, _ = fmt.Fprint(w, `{"status":"success","data":{"resultType":"vector","result":[]}}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	p, err := client.NewPrometheus(nil, client.PrometheusOptions{
+		Address: srv.URL,
+		Queries: client.PrometheusQueries{PodMEM: "mem{$namespace}", PodNetRx: "rx"},
+	})
+	require.NoError(t, err)
+
+	_, err = p.FetchPodsMetrics(context.Background(), "ns1")
+	require.NoError(t, err)
+	_, err = p.FetchPodsExtMetrics(context.Background(), "ns1")
+	require.NoError(t, err)
+	_, err = p.FetchPodsMetrics(context.Background(), client.BlankNamespace)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		`sum by (namespace, pod, container) (rate(container_cpu_usage_seconds_total{container!="",container!="POD",namespace="ns1"}[5m]))`,
+		`mem{namespace="ns1"}`,
+		`rx`,
+		`sum by (namespace, pod) (rate(container_network_transmit_bytes_total{namespace="ns1"}[5m]))`,
+		`sum by (namespace, pod) (increase(kube_pod_container_status_restarts_total{namespace="ns1"}[1h]))`,
+		`sum by (namespace, pod, container) (rate(container_cpu_usage_seconds_total{container!="",container!="POD",namespace!=""}[5m]))`,
+		`mem{namespace!=""}`,
+	}, qq)
+}
@@ -169,3 +169,18 @@ type NodesMetrics map[string]NodeMetrics
 
 // PodsMetrics tracks usage metrics per pods.
 type PodsMetrics map[string]PodMetrics
+
+// PodExtMetrics tracks extra pod series not served by metrics-server.
+type PodExtMetrics struct {
+	// NetRx tracks received bytes per second.
+	NetRx float64
+
+	// NetTx tracks transmitted bytes per second.
+	NetTx float64
+
+	// RestartsRate tracks container restarts per hour.
+	RestartsRate float64
+}
+
+// PodsExtMetricsMap tracks extra pod metrics keyed by pod fqn.
+type PodsExtMetricsMap map[string]*PodExtMetrics
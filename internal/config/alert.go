@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/config/json"
+	"github.com/derailed/k9s/internal/slogs"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// AlertPodRestarts fires when pods restart more than threshold times within the window.
+	AlertPodRestarts = "podRestarts"
+
+	// AlertNodeNotReady fires when a node is not ready.
+	AlertNodeNotReady = "nodeNotReady"
+
+	// AlertPVCUsage fires when a volume usage exceeds threshold percent.
+	AlertPVCUsage = "pvcUsage"
+
+	// AlertWarningEvent fires on warning events seen within the window.
+	AlertWarningEvent = "warningEvent"
+
+	// AlertWarn represents a warning severity.
+	AlertWarn = "warn"
+
+	// AlertCritical represents a critical severity.
+	AlertCritical = "critical"
+
+	// DefaultAlertWindow represents the default rule evaluation window.
+	DefaultAlertWindow = 10 * time.Minute
+)
+
+// Alerts represents a collection of alert rules.
+type Alerts struct {
+	Alerts AlertSpec `yaml:"alerts"`
+}
+
+// AlertSpec describes alert rules.
+type AlertSpec struct {
+	Rules []AlertRule `yaml:"rules"`
+}
+
+// AlertRule describes a threshold alert rule.
+type AlertRule struct {
+	Name      string `yaml:"name"`
+	Kind      string `yaml:"kind"`
+	Severity  string `yaml:"severity"`
+	Namespace string `yaml:"namespace"`
+	Selector  string `yaml:"selector"`
+	Reason    string `yaml:"reason"`
+	Threshold int    `yaml:"threshold"`
+	Window    string `yaml:"window"`
+}
+
+// NewAlerts returns a new instance.
+func NewAlerts() *Alerts {
+	return &Alerts{}
+}
+
+// Load loads the global alert rules followed by the context specific ones.
+// Context rules override global rules with the same name.
+func (a *Alerts) Load(path string) error {
+	if err := a.LoadAlerts(AppAlertsFile); err != nil {
+		return err
+	}
+	if path == "" {
+		return nil
+	}
+
+	return a.LoadAlerts(path)
+}
+
+// LoadAlerts loads alert rules from a given file.
+func (a *Alerts) LoadAlerts(path string) error {
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := data.JSONValidator.Validate(json.AlertsSchema, bb); err != nil {
+		slog.Warn("Validation failed. Please update your config and restart.",
+			slogs.Path, path,
+			slogs.Error, err,
+		)
+	}
+
+	var aa Alerts
+	if err := yaml.Unmarshal(bb, &aa); err != nil {
+		return err
+	}
+	for _, r := range aa.Alerts.Rules {
+		a.upsert(r)
+	}
+
+	return nil
+}
+
+func (a *Alerts) upsert(r AlertRule) {
+	for i := range a.Alerts.Rules {
+		if a.Alerts.Rules[i].Name == r.Name {
+			a.Alerts.Rules[i] = r
+			return
+		}
+	}
+	a.Alerts.Rules = append(a.Alerts.Rules, r)
+}
+
+// Rules returns all valid rules. Invalid rules are reported and skipped.
+func (a *Alerts) Rules() ([]AlertRule, error) {
+	var (
+		errs error
+		rr   = make([]AlertRule, 0, len(a.Alerts.Rules))
+	)
+	for _, r := range a.Alerts.Rules {
+		if err := r.Validate(); err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		rr = append(rr, r)
+	}
+
+	return rr, errs
+}
+
+// Validate checks the rule is well formed.
+func (r AlertRule) Validate() error {
+	if r.Name == "" {
+		return errors.New("alert rule name is required")
+	}
+	switch r.Kind {
+	case AlertPodRestarts, AlertNodeNotReady, AlertPVCUsage, AlertWarningEvent:
+	default:
+		return fmt.Errorf("alert rule %q: unknown kind %q", r.Name, r.Kind)
+	}
+	if r.Threshold < 0 {
+		return fmt.Errorf("alert rule %q: threshold must be positive", r.Name)
+	}
+	if _, err := r.WindowDuration(); err != nil {
+		return fmt.Errorf("alert rule %q: %w", r.Name, err)
+	}
+	if r.Reason != "" {
+		if _, err := regexp.Compile(r.Reason); err != nil {
+			return fmt.Errorf("alert rule %q: invalid reason: %w", r.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// SeverityOrDefault returns the rule severity, defaulting to warn.
+func (r AlertRule) SeverityOrDefault() string {
+	if r.Severity == "" {
+		return AlertWarn
+	}
+
+	return r.Severity
+}
+
+// WindowDuration returns the rule evaluation window.
+func (r AlertRule) WindowDuration() (time.Duration, error) {
+	if r.Window == "" {
+		return DefaultAlertWindow, nil
+	}
+	d, err := time.ParseDuration(r.Window)
+	if err != nil {
+		return 0, fmt.Errorf("invalid window %q: %w", r.Window, err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid window %q", r.Window)
+	}
+
+	return d, nil
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertsLoad(t *testing.T) {
+	a := config.NewAlerts()
+	require.NoError(t, a.LoadAlerts("testdata/alerts/alerts.yaml"))
+	require.NoError(t, a.LoadAlerts("testdata/alerts/context.yaml"))
+	assert.Len(t, a.Alerts.Rules, 4)
+	assert.Equal(t, config.AlertRule{Name: "crashy-pods", Kind: config.AlertPodRestarts, Threshold: 10}, a.Alerts.Rules[0])
+
+	rr, err := a.Rules()
+	require.EqualError(t, err, `alert rule "bozo": unknown kind "podCrashes"`)
+	assert.Len(t, rr, 3)
+	assert.Equal(t, "blee-warnings", rr[2].Name)
+}
+
+func TestAlertRuleWindow(t *testing.T) {
+	uu := map[string]struct {
+		w   string
+		e   time.Duration
+		err bool
+	}{
+		"default": {e: config.DefaultAlertWindow},
+		"custom":  {w: "2m", e: 2 * time.Minute},
+		"toast":   {w: "soon", err: true},
+		"neg":     {w: "-1m", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			d, err := config.AlertRule{Window: u.w}.WindowDuration()
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.e, d)
+		})
+	}
+}
@@ -191,6 +191,9 @@ func (a *Aliases) loadDefaultAliases() {
 	a.declare(client.UsrGVR, "user", "usr")
 	a.declare(client.GrpGVR, "group", "grp")
 	a.declare(client.PfGVR, "portforward", "pf")
+	a.declare(client.PfpGVR, "pf-profile", "pfp")
+	a.declare(client.AlGVR, "alert", "al")
+	a.declare(client.VdbGVR, "vuldb", "vdb")
 	a.declare(client.BeGVR, "benchmark", "bench")
 	a.declare(client.SdGVR, "screendump", "sd")
 	a.declare(client.PuGVR, "pulse", "pu", "hz")
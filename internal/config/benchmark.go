@@ -4,8 +4,10 @@
 package config
 
 import (
+	"fmt"
 	"net/http"
 	"os"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
@@ -34,27 +36,42 @@ type (
 
 	// Benchmark represents a generic benchmark.
 	Benchmark struct {
-		C int `yaml:"concurrency"`
-		N int `yaml:"requests"`
+		C        int     `yaml:"concurrency"`
+		N        int     `yaml:"requests"`
+		QPS      float64 `yaml:"qps,omitempty"`
+		Duration string  `yaml:"duration,omitempty"`
 	}
 
 	// HTTP represents an http request.
 	HTTP struct {
-		Method  string      `yaml:"method"`
-		Host    string      `yaml:"host"`
-		Path    string      `yaml:"path"`
-		HTTP2   bool        `yaml:"http2"`
-		Body    string      `yaml:"body"`
-		Headers http.Header `yaml:"headers"`
+		Method   string      `yaml:"method"`
+		Host     string      `yaml:"host"`
+		Path     string      `yaml:"path"`
+		HTTP2    bool        `yaml:"http2"`
+		Body     string      `yaml:"body"`
+		BodyFile string      `yaml:"bodyFile,omitempty"`
+		Headers  http.Header `yaml:"headers"`
+	}
+
+	// BenchStage represents a load stage. Stages run back to back and
+	// either last for a given duration or a given number of requests.
+	BenchStage struct {
+		C        int     `yaml:"concurrency"`
+		N        int     `yaml:"requests,omitempty"`
+		QPS      float64 `yaml:"qps,omitempty"`
+		Duration string  `yaml:"duration,omitempty"`
 	}
 
 	// BenchConfig represents a service benchmark.
 	BenchConfig struct {
-		Name string
-		C    int  `yaml:"concurrency"`
-		N    int  `yaml:"requests"`
-		Auth Auth `yaml:"auth"`
-		HTTP HTTP `yaml:"http"`
+		Name     string
+		C        int          `yaml:"concurrency"`
+		N        int          `yaml:"requests"`
+		QPS      float64      `yaml:"qps,omitempty"`
+		Duration string       `yaml:"duration,omitempty"`
+		Stages   []BenchStage `yaml:"stages,omitempty"`
+		Auth     Auth         `yaml:"auth"`
+		HTTP     HTTP         `yaml:"http"`
 	}
 )
 
@@ -79,6 +96,67 @@ func DefaultBenchSpec() BenchConfig {
 	}
 }
 
+// LoadStages returns the load stages to run. Configs without stages run
+// a single stage using the top level concurrency, requests, qps and duration.
+func (b BenchConfig) LoadStages() ([]BenchStage, error) {
+	ss := b.Stages
+	if len(ss) == 0 {
+		ss = []BenchStage{{C: b.C, N: b.N, QPS: b.QPS, Duration: b.Duration}}
+	}
+	stages := make([]BenchStage, 0, len(ss))
+	for i, s := range ss {
+		if s.C <= 0 {
+			s.C = max(b.C, DefaultC)
+		}
+		if s.Duration == "" && s.N <= 0 {
+			return nil, fmt.Errorf("benchmark stage #%d must specify a duration or a number of requests", i+1)
+		}
+		if s.QPS < 0 {
+			return nil, fmt.Errorf("benchmark stage #%d qps must be positive", i+1)
+		}
+		if _, err := s.StageDuration(); err != nil {
+			return nil, fmt.Errorf("benchmark stage #%d: %w", i+1, err)
+		}
+		stages = append(stages, s)
+	}
+
+	return stages, nil
+}
+
+// RequestBody returns the request body. A body file takes precedence over an inline body.
+func (b BenchConfig) RequestBody() ([]byte, error) {
+	if b.HTTP.BodyFile == "" {
+		return []byte(b.HTTP.Body), nil
+	}
+
+	return os.ReadFile(b.HTTP.BodyFile)
+}
+
+// StageDuration returns the stage duration or zero when the stage is request based.
+func (s BenchStage) StageDuration() (time.Duration, error) {
+	if s.Duration == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(s.Duration)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: %w", s.Duration, err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid duration %q: must be positive", s.Duration)
+	}
+
+	return d, nil
+}
+
+// WorkerQPS returns the rate limit per worker given the stage overall qps.
+func (s BenchStage) WorkerQPS() float64 {
+	if s.QPS <= 0 || s.C <= 0 {
+		return 0
+	}
+
+	return s.QPS / float64(s.C)
+}
+
 func newBenchmark() Benchmark {
 	return Benchmark{
 		C: DefaultC,
@@ -192,3 +192,95 @@ func TestBenchContainerLoad(t *testing.T) {
 		})
 	}
 }
+
+func TestBenchStagesLoad(t *testing.T) {
+	b, err := NewBench("testdata/benchmarks/b_stages.yaml")
+	require.NoError(t, err)
+
+	uu := map[string]struct {
+		key  string
+		e    []BenchStage
+		body string
+	}{
+		"single": {
+			key: "default/nginx",
+			e: []BenchStage{
+				{C: 5, QPS: 100, Duration: "30s"},
+			},
+			body: "{\"fred\": \"zorg\"}\n",
+		},
+		"stages": {
+			key: "blee/fred",
+			e: []BenchStage{
+				{C: 5, N: 500},
+				{C: 10, QPS: 50, Duration: "1m"},
+				{C: 2, N: 200},
+			},
+			body: `{"fred": "blee"}`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg := b.Benchmarks.Services[u.key]
+			ss, err := cfg.LoadStages()
+			require.NoError(t, err)
+			assert.Equal(t, u.e, ss)
+
+			bb, err := cfg.RequestBody()
+			require.NoError(t, err)
+			assert.Equal(t, u.body, string(bb))
+		})
+	}
+}
+
+func TestBenchLoadStagesToast(t *testing.T) {
+	uu := map[string]struct {
+		cfg BenchConfig
+		err string
+	}{
+		"no-bounds": {
+			cfg: BenchConfig{Stages: []BenchStage{{C: 1}}},
+			err: "benchmark stage #1 must specify a duration or a number of requests",
+		},
+		"bad-qps": {
+			cfg: BenchConfig{Stages: []BenchStage{{N: 10}, {N: 10, QPS: -1}}},
+			err: "benchmark stage #2 qps must be positive",
+		},
+		"bad-duration": {
+			cfg: BenchConfig{Duration: "fred"},
+			err: `benchmark stage #1: invalid duration "fred": time: invalid duration "fred"`,
+		},
+		"neg-duration": {
+			cfg: BenchConfig{Duration: "-1s"},
+			err: `benchmark stage #1: invalid duration "-1s": must be positive`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			_, err := u.cfg.LoadStages()
+			assert.EqualError(t, err, u.err)
+		})
+	}
+}
+
+func TestBenchStageWorkerQPS(t *testing.T) {
+	uu := map[string]struct {
+		s BenchStage
+		e float64
+	}{
+		"unbound": {s: BenchStage{C: 2}},
+		"split":   {s: BenchStage{C: 4, QPS: 100}, e: 25},
+		"no-c":    {s: BenchStage{QPS: 100}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.InDelta(t, u.e, u.s.WorkerQPS(), 0.001)
+		})
+	}
+}
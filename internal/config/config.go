@@ -60,6 +60,36 @@ func (c *Config) ContextHotkeysPath() string {
 	return AppContextHotkeysFile(ct.ClusterName, c.K9s.activeContextName)
 }
 
+// ContextLogHighlightsPath returns a context specific log highlights file spec.
+func (c *Config) ContextLogHighlightsPath() string {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return ""
+	}
+
+	return AppContextLogHighlightsFile(ct.ClusterName, c.K9s.activeContextName)
+}
+
+// ContextPFProfilesPath returns a context specific port-forward profiles file spec.
+func (c *Config) ContextPFProfilesPath() string {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return ""
+	}
+
+	return AppContextPFProfilesFile(ct.ClusterName, c.K9s.activeContextName)
+}
+
+// ContextAlertsPath returns a context specific alert rules file spec.
+func (c *Config) ContextAlertsPath() string {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return ""
+	}
+
+	return AppContextAlertsFile(ct.ClusterName, c.K9s.activeContextName)
+}
+
 // ContextAliasesPath returns a context specific aliases file spec.
 func (c *Config) ContextAliasesPath() string {
 	ct, err := c.K9s.ActiveContext()
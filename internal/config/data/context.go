@@ -20,6 +20,7 @@ type Context struct {
 	View         *View        `yaml:"view"`
 	FeatureGates FeatureGates `yaml:"featureGates"`
 	Proxy        *Proxy       `yaml:"proxy"`
+	Metrics      *Metrics     `yaml:"metrics,omitempty"`
 	mx           sync.RWMutex
 }
 
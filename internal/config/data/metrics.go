@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package data
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+// Metrics tracks a context's metrics provider configuration.
+type Metrics struct {
+	Provider   string      `yaml:"provider"`
+	Prometheus *Prometheus `yaml:"prometheus,omitempty"`
+}
+
+// Prometheus tracks a prometheus metrics provider configuration.
+type Prometheus struct {
+	Address            string            `yaml:"address,omitempty"`
+	Service            string            `yaml:"service,omitempty"`
+	BearerTokenFile    string            `yaml:"bearerTokenFile,omitempty"`
+	InsecureSkipVerify bool              `yaml:"insecureSkipVerify,omitempty"`
+	Timeout            string            `yaml:"timeout,omitempty"`
+	Queries            PrometheusQueries `yaml:"queries,omitempty"`
+}
+
+// PrometheusQueries tracks custom PromQL queries.
+type PrometheusQueries struct {
+	NodeCPU     string `yaml:"nodeCPU,omitempty"`
+	NodeMEM     string `yaml:"nodeMEM,omitempty"`
+	PodCPU      string `yaml:"podCPU,omitempty"`
+	PodMEM      string `yaml:"podMEM,omitempty"`
+	PodNetRx    string `yaml:"podNetRx,omitempty"`
+	PodNetTx    string `yaml:"podNetTx,omitempty"`
+	PodRestarts string `yaml:"podRestarts,omitempty"`
+}
+
+// ProviderFn returns a metrics provider builder or nil when metrics-server is used.
+func (m *Metrics) ProviderFn() (client.MetricsProviderFn, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	switch strings.ToLower(m.Provider) {
+	case "", client.MetricsServerProviderName:
+		return nil, nil
+	case client.PrometheusProviderName:
+		if m.Prometheus == nil {
+			return nil, fmt.Errorf("no prometheus configuration specified")
+		}
+		opts, err := m.Prometheus.options()
+		if err != nil {
+			return nil, err
+		}
+		return func(c client.Connection) (client.MetricsProvider, error) {
+			return client.NewPrometheus(c, opts)
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown metrics provider %q", m.Provider)
+	}
+}
+
+func (p *Prometheus) options() (client.PrometheusOptions, error) {
+	opts := client.PrometheusOptions{
+		Address:            p.Address,
+		Service:            p.Service,
+		InsecureSkipVerify: p.InsecureSkipVerify,
+		Queries: client.PrometheusQueries{
+			NodeCPU:     p.Queries.NodeCPU,
+			NodeMEM:     p.Queries.NodeMEM,
+			PodCPU:      p.Queries.PodCPU,
+			PodMEM:      p.Queries.PodMEM,
+			PodNetRx:    p.Queries.PodNetRx,
+			PodNetTx:    p.Queries.PodNetTx,
+			PodRestarts: p.Queries.PodRestarts,
+		},
+	}
+	if p.Timeout != "" {
+		d, err := time.ParseDuration(p.Timeout)
+		if err != nil {
+			return opts, fmt.Errorf("invalid prometheus timeout %q: %w", p.Timeout, err)
+		}
+		opts.Timeout = d
+	}
+	if p.BearerTokenFile != "" {
+		bb, err := os.ReadFile(p.BearerTokenFile)
+		if err != nil {
+			return opts, fmt.Errorf("unable to read prometheus token: %w", err)
+		}
+		opts.BearerToken = strings.TrimSpace(string(bb))
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package data_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsProviderFn(t *testing.T) {
+	uu := map[string]struct {
+		m      *data.Metrics
+		hasFn  bool
+		hasErr bool
+	}{
+		"none": {},
+		"metrics-server": {
+			m: &data.Metrics{Provider: client.MetricsServerProviderName},
+		},
+		"prometheus": {
+			m: &data.Metrics{
+				Provider:   "Prometheus",
+				Prometheus: &data.Prometheus{Address: "http://localhost:9090", Timeout: "5s"},
+			},
+			hasFn: true,
+		},
+		"prometheus-missing": {
+			m:      &data.Metrics{Provider: client.PrometheusProviderName},
+			hasErr: true,
+		},
+		"bad-timeout": {
+			m: &data.Metrics{
+				Provider:   client.PrometheusProviderName,
+				Prometheus: &data.Prometheus{Address: "http://localhost:9090", Timeout: "soon"},
+			},
+			hasErr: true,
+		},
+		"unknown": {
+			m:      &data.Metrics{Provider: "datadog"},
+			hasErr: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			fn, err := u.m.ProviderFn()
+			if u.hasErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.hasFn, fn != nil)
+			if fn == nil {
+				return
+			}
+			p, err := fn(nil)
+			require.NoError(t, err)
+			assert.Equal(t, client.PrometheusProviderName, p.Name())
+		})
+	}
+}
@@ -60,6 +60,9 @@ var (
 	// AppDumpsDir tracks screen dumps data directory.
 	AppDumpsDir string
 
+	// AppMetricsDir tracks metrics history directory.
+	AppMetricsDir string
+
 	// AppContextsDir tracks contexts data directory.
 	AppContextsDir string
 
@@ -83,6 +86,12 @@ var (
 
 	// AppHotKeysFile tracks hotkeys config file.
 	AppHotKeysFile string
+
+	// AppLogHighlightsFile tracks log highlights config file.
+	AppLogHighlightsFile string
+
+	// AppAlertsFile tracks alert rules config file.
+	AppAlertsFile string
 )
 
 // InitLogLoc initializes K9s logs location.
@@ -138,6 +147,7 @@ func initK9sEnvLocs() error {
 			slogs.Error, err,
 		)
 	}
+	AppMetricsDir = filepath.Join(AppConfigDir, "metrics")
 	AppSkinsDir = filepath.Join(AppConfigDir, "skins")
 	if err := data.EnsureFullPath(AppSkinsDir, data.DefaultDirMod); err != nil {
 		slog.Warn("Unable to create skins dir",
@@ -159,6 +169,8 @@ func initK9sEnvLocs() error {
 	AppPluginsFile = filepath.Join(AppConfigDir, "plugins.yaml")
 	AppViewsFile = filepath.Join(AppConfigDir, "views.yaml")
 	AppJumpsFile = filepath.Join(AppConfigDir, "jumps.yaml")
+	AppLogHighlightsFile = filepath.Join(AppConfigDir, "log_highlights.yaml")
+	AppAlertsFile = filepath.Join(AppConfigDir, "alerts.yaml")
 
 	return nil
 }
@@ -181,6 +193,8 @@ func initXDGLocs() error {
 	AppPluginsFile = filepath.Join(AppConfigDir, "plugins.yaml")
 	AppViewsFile = filepath.Join(AppConfigDir, "views.yaml")
 	AppJumpsFile = filepath.Join(AppConfigDir, "jumps.yaml")
+	AppLogHighlightsFile = filepath.Join(AppConfigDir, "log_highlights.yaml")
+	AppAlertsFile = filepath.Join(AppConfigDir, "alerts.yaml")
 
 	AppSkinsDir = filepath.Join(AppConfigDir, "skins")
 	if e := data.EnsureFullPath(AppSkinsDir, data.DefaultDirMod); e != nil {
@@ -200,6 +214,14 @@ func initXDGLocs() error {
 		)
 	}
 
+	AppMetricsDir, err = xdg.StateFile(filepath.Join(AppName, "metrics"))
+	if err != nil {
+		slog.Warn("No metrics dir detected",
+			slogs.Dir, AppMetricsDir,
+			slogs.Error, err,
+		)
+	}
+
 	dataDir, err := xdg.DataFile(AppName)
 	if err != nil {
 		return err
@@ -235,6 +257,21 @@ func AppContextHotkeysFile(cluster, context string) string {
 	return filepath.Join(AppContextsDir, data.SanitizeContextSubpath(cluster, context), "hotkeys.yaml")
 }
 
+// AppContextLogHighlightsFile generates a valid context specific log highlights file path.
+func AppContextLogHighlightsFile(cluster, context string) string {
+	return filepath.Join(AppContextsDir, data.SanitizeContextSubpath(cluster, context), "log_highlights.yaml")
+}
+
+// AppContextPFProfilesFile generates a valid context specific port-forward profiles file path.
+func AppContextPFProfilesFile(cluster, context string) string {
+	return filepath.Join(AppContextsDir, data.SanitizeContextSubpath(cluster, context), "pf_profiles.yaml")
+}
+
+// AppContextAlertsFile generates a valid context specific alert rules file path.
+func AppContextAlertsFile(cluster, context string) string {
+	return filepath.Join(AppContextsDir, data.SanitizeContextSubpath(cluster, context), "alerts.yaml")
+}
+
 // AppContextConfig generates a valid context config file path.
 func AppContextConfig(cluster, context string) string {
 	return filepath.Join(AppContextDir(cluster, context), data.MainConfigFile)
@@ -29,6 +29,7 @@ type Flags struct {
 	Splashless    *bool
 	Invert        *bool
 	ScreenDumpDir *string
+	Snapshot      *string
 }
 
 // NewFlags returns new configuration flags.
@@ -47,6 +48,7 @@ func NewFlags() *Flags {
 		Splashless:    boolPtr(false),
 		Invert:        boolPtr(false),
 		ScreenDumpDir: strPtr(AppDumpsDir),
+		Snapshot:      strPtr(""),
 	}
 }
 
@@ -46,6 +46,15 @@ const (
 
 	// JumpsSchema describes jumps config schema.
 	JumpsSchema = "jumps.json"
+
+	// LogHighlightsSchema describes log highlights config schema.
+	LogHighlightsSchema = "log-highlights.json"
+
+	// AlertsSchema describes alert rules config schema.
+	AlertsSchema = "alerts.json"
+
+	// PFProfilesSchema describes port-forward profiles config schema.
+	PFProfilesSchema = "pf-profiles.json"
 )
 
 var (
@@ -78,6 +87,15 @@ var (
 
 	//go:embed schemas/jumps.json
 	jumpsSchema string
+
+	//go:embed schemas/log-highlights.json
+	logHighlightsSchema string
+
+	//go:embed schemas/alerts.json
+	alertsSchema string
+
+	//go:embed schemas/pf-profiles.json
+	pfProfilesSchema string
 )
 
 // Validator tracks schemas validation.
@@ -90,16 +108,19 @@ type Validator struct {
 func NewValidator() *Validator {
 	v := Validator{
 		schemas: map[string]gojsonschema.JSONLoader{
-			K9sSchema:         gojsonschema.NewStringLoader(k9sSchema),
-			ContextSchema:     gojsonschema.NewStringLoader(contextSchema),
-			AliasesSchema:     gojsonschema.NewStringLoader(aliasSchema),
-			ViewsSchema:       gojsonschema.NewStringLoader(viewsSchema),
-			PluginsSchema:     gojsonschema.NewStringLoader(pluginsSchema),
-			PluginSchema:      gojsonschema.NewStringLoader(pluginSchema),
-			PluginMultiSchema: gojsonschema.NewStringLoader(pluginMultiSchema),
-			HotkeysSchema:     gojsonschema.NewStringLoader(hotkeysSchema),
-			SkinSchema:        gojsonschema.NewStringLoader(skinSchema),
-			JumpsSchema:       gojsonschema.NewStringLoader(jumpsSchema),
+			K9sSchema:           gojsonschema.NewStringLoader(k9sSchema),
+			ContextSchema:       gojsonschema.NewStringLoader(contextSchema),
+			AliasesSchema:       gojsonschema.NewStringLoader(aliasSchema),
+			ViewsSchema:         gojsonschema.NewStringLoader(viewsSchema),
+			PluginsSchema:       gojsonschema.NewStringLoader(pluginsSchema),
+			PluginSchema:        gojsonschema.NewStringLoader(pluginSchema),
+			PluginMultiSchema:   gojsonschema.NewStringLoader(pluginMultiSchema),
+			HotkeysSchema:       gojsonschema.NewStringLoader(hotkeysSchema),
+			SkinSchema:          gojsonschema.NewStringLoader(skinSchema),
+			JumpsSchema:         gojsonschema.NewStringLoader(jumpsSchema),
+			LogHighlightsSchema: gojsonschema.NewStringLoader(logHighlightsSchema),
+			AlertsSchema:        gojsonschema.NewStringLoader(alertsSchema),
+			PFProfilesSchema:    gojsonschema.NewStringLoader(pfProfilesSchema),
 		},
 	}
 	v.register()
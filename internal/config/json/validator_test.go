@@ -238,3 +238,92 @@ columns is required`,
 		})
 	}
 }
+
+func TestValidateLogHighlights(t *testing.T) {
+	uu := map[string]struct {
+		f   string
+		err string
+	}{
+		"happy": {
+			f: "testdata/log-highlights/cool.yaml",
+		},
+		"toast": {
+			f: "testdata/log-highlights/toast.yaml",
+			err: `Additional property color is not allowed
+logHighlights.presets.0 must be one of the following: "levels", "http-status", "stack-traces"
+style is required`,
+		},
+	}
+
+	v := json.NewValidator()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			bb, err := os.ReadFile(u.f)
+			require.NoError(t, err)
+			if err := v.Validate(json.LogHighlightsSchema, bb); err != nil {
+				assert.Equal(t, u.err, err.Error())
+			}
+		})
+	}
+}
+
+func TestValidateAlerts(t *testing.T) {
+	uu := map[string]struct {
+		f   string
+		err string
+	}{
+		"happy": {
+			f: "testdata/alerts/cool.yaml",
+		},
+		"toast": {
+			f: "testdata/alerts/toast.yaml",
+			err: `Additional property color is not allowed
+Must be greater than or equal to 0
+alerts.rules.0.kind must be one of the following: "podRestarts", "nodeNotReady", "pvcUsage", "warningEvent"
+name is required`,
+		},
+	}
+
+	v := json.NewValidator()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			bb, err := os.ReadFile(u.f)
+			require.NoError(t, err)
+			if err := v.Validate(json.AlertsSchema, bb); err != nil {
+				assert.Equal(t, u.err, err.Error())
+			}
+		})
+	}
+}
+
+func TestValidatePFProfiles(t *testing.T) {
+	uu := map[string]struct {
+		f   string
+		err string
+	}{
+		"happy": {
+			f: "testdata/pf_profiles/cool.yaml",
+		},
+		"toast": {
+			f: "testdata/pf_profiles/toast.yaml",
+			err: `Additional property port is not allowed
+Invalid type. Expected: boolean, given: string
+containerPort is required
+name is required`,
+		},
+	}
+
+	v := json.NewValidator()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			bb, err := os.ReadFile(u.f)
+			require.NoError(t, err)
+			if err := v.Validate(json.PFProfilesSchema, bb); err != nil {
+				assert.Equal(t, u.err, err.Error())
+			}
+		})
+	}
+}
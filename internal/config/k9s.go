@@ -34,23 +34,24 @@ var defaultGPUVendors = gpuVendors{
 
 // K9s tracks K9s configuration options.
 type K9s struct {
-	LiveViewAutoRefresh bool       `json:"liveViewAutoRefresh" yaml:"liveViewAutoRefresh"`
-	GPUVendors          gpuVendors `json:"gpuVendors" yaml:"gpuVendors"`
-	ScreenDumpDir       string     `json:"screenDumpDir" yaml:"screenDumpDir,omitempty"`
-	RefreshRate         float32    `json:"refreshRate" yaml:"refreshRate"`
-	APIServerTimeout    string     `json:"apiServerTimeout" yaml:"apiServerTimeout"`
-	MaxConnRetry        int32      `json:"maxConnRetry" yaml:"maxConnRetry"`
-	ReadOnly            bool       `json:"readOnly" yaml:"readOnly"`
-	NoExitOnCtrlC       bool       `json:"noExitOnCtrlC" yaml:"noExitOnCtrlC"`
-	PortForwardAddress  string     `json:"portForwardAddress" yaml:"portForwardAddress"`
-	UI                  UI         `json:"ui" yaml:"ui"`
-	SkipLatestRevCheck  bool       `json:"skipLatestRevCheck" yaml:"skipLatestRevCheck"`
-	DisablePodCounting  bool       `json:"disablePodCounting" yaml:"disablePodCounting"`
-	ShellPod            *ShellPod  `json:"shellPod" yaml:"shellPod"`
-	ImageScans          ImageScans `json:"imageScans" yaml:"imageScans"`
-	Logger              Logger     `json:"logger" yaml:"logger"`
-	Thresholds          Threshold  `json:"thresholds" yaml:"thresholds"`
-	DefaultView         string     `json:"defaultView" yaml:"defaultView"`
+	LiveViewAutoRefresh bool           `json:"liveViewAutoRefresh" yaml:"liveViewAutoRefresh"`
+	GPUVendors          gpuVendors     `json:"gpuVendors" yaml:"gpuVendors"`
+	ScreenDumpDir       string         `json:"screenDumpDir" yaml:"screenDumpDir,omitempty"`
+	RefreshRate         float32        `json:"refreshRate" yaml:"refreshRate"`
+	APIServerTimeout    string         `json:"apiServerTimeout" yaml:"apiServerTimeout"`
+	MaxConnRetry        int32          `json:"maxConnRetry" yaml:"maxConnRetry"`
+	ReadOnly            bool           `json:"readOnly" yaml:"readOnly"`
+	NoExitOnCtrlC       bool           `json:"noExitOnCtrlC" yaml:"noExitOnCtrlC"`
+	PortForwardAddress  string         `json:"portForwardAddress" yaml:"portForwardAddress"`
+	UI                  UI             `json:"ui" yaml:"ui"`
+	SkipLatestRevCheck  bool           `json:"skipLatestRevCheck" yaml:"skipLatestRevCheck"`
+	DisablePodCounting  bool           `json:"disablePodCounting" yaml:"disablePodCounting"`
+	ShellPod            *ShellPod      `json:"shellPod" yaml:"shellPod"`
+	ImageScans          ImageScans     `json:"imageScans" yaml:"imageScans"`
+	Logger              Logger         `json:"logger" yaml:"logger"`
+	MetricsHistory      MetricsHistory `json:"metricsHistory" yaml:"metricsHistory"`
+	Thresholds          Threshold      `json:"thresholds" yaml:"thresholds"`
+	DefaultView         string         `json:"defaultView" yaml:"defaultView"`
 	manualRefreshRate   float32
 	manualReadOnly      *bool
 	manualCommand       *string
@@ -74,6 +75,7 @@ func NewK9s(conn client.Connection, ks data.KubeSettings) *K9s {
 		APIServerTimeout:   client.DefaultCallTimeoutDuration.String(),
 		ScreenDumpDir:      AppDumpsDir,
 		Logger:             NewLogger(),
+		MetricsHistory:     NewMetricsHistory(),
 		Thresholds:         NewThreshold(),
 		PortForwardAddress: defaultPFAddress(),
 		ShellPod:           NewShellPod(),
@@ -149,6 +151,7 @@ func (k *K9s) Merge(k1 *K9s) {
 	k.DisablePodCounting = k1.DisablePodCounting
 	k.ShellPod = k1.ShellPod
 	k.Logger = k1.Logger
+	k.MetricsHistory = k1.MetricsHistory
 	k.ImageScans = k1.ImageScans
 	if k1.Thresholds != nil {
 		k.Thresholds = k1.Thresholds
@@ -174,6 +177,11 @@ func (k *K9s) ContextScreenDumpDir() string {
 	return filepath.Join(k.AppScreenDumpDir(), k.contextPath())
 }
 
+// ContextMetricsHistoryFile returns the active context metrics history file.
+func (k *K9s) ContextMetricsHistoryFile() string {
+	return filepath.Join(AppMetricsDir, k.contextPath(), "metrics.csv")
+}
+
 func (k *K9s) contextPath() string {
 	if k.getActiveConfig() == nil {
 		return "na"
@@ -287,6 +295,12 @@ func (k *K9s) ActivateContext(contextName string) (*data.Context, error) {
 		}
 	}
 
+	fn, err := cfg.Context.Metrics.ProviderFn()
+	if err != nil {
+		slog.Warn("Invalid metrics provider config. Using metrics-server", slogs.Context, contextName, slogs.Error, err)
+	}
+	client.SetMetricsProvider(fn)
+
 	k.Validate(k.conn, contextName, ct.Cluster)
 	// If the context specifies a namespace, use it!
 	if ns := ct.Namespace; ns != client.BlankNamespace {
@@ -455,7 +469,9 @@ func (k *K9s) Validate(c client.Connection, contextName, clusterName string) {
 		k.ShellPod.Validate()
 	}
 	k.Logger = k.Logger.Validate()
+	k.MetricsHistory = k.MetricsHistory.Validate()
 	k.Thresholds = k.Thresholds.Validate()
+	k.ImageScans = k.ImageScans.Validate()
 
 	if cfg := k.getActiveConfig(); cfg != nil {
 		cfg.Validate(c, contextName, clusterName)
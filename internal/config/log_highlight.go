@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/config/json"
+	"github.com/derailed/k9s/internal/slogs"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// HighlightError represents the skin error highlight color.
+	HighlightError = "error"
+
+	// HighlightWarn represents the skin warn highlight color.
+	HighlightWarn = "warn"
+
+	// HighlightInfo represents the skin info highlight color.
+	HighlightInfo = "info"
+
+	// HighlightDebug represents the skin debug highlight color.
+	HighlightDebug = "debug"
+)
+
+// LogHighlightPresets tracks built-in log highlight rules by name.
+var LogHighlightPresets = map[string][]LogHighlight{
+	"levels": {
+		{Pattern: `(?i)\b(?:error|err|fatal|panic|crit(?:ical)?)\b`, Style: HighlightError + "::b"},
+		{Pattern: `(?i)\bwarn(?:ing)?\b`, Style: HighlightWarn + "::b"},
+		{Pattern: `\bINFO\b`, Style: HighlightInfo},
+		{Pattern: `\b(?:DEBUG|TRACE)\b`, Style: HighlightDebug},
+	},
+	"http-status": {
+		{Pattern: `\b5\d{2}\b`, Style: HighlightError + "::b"},
+		{Pattern: `\b4\d{2}\b`, Style: HighlightWarn},
+		{Pattern: `\b[23]\d{2}\b`, Style: HighlightInfo},
+	},
+	"stack-traces": {
+		{Pattern: `^(?:panic:|fatal error:|Traceback \(most recent call last\)|Exception in thread)`, Style: HighlightError + "::b", Line: true},
+		{Pattern: `^goroutine \d+ \[`, Style: HighlightWarn, Line: true},
+		{Pattern: `^\s+at \S+\(.*\)`, Style: HighlightWarn, Line: true},
+		{Pattern: `^\s+File ".+", line \d+`, Style: HighlightWarn, Line: true},
+		{Pattern: `^\s+\S+\.go:\d+`, Style: HighlightWarn, Line: true},
+	},
+}
+
+// LogHighlights represents a collection of log highlight rules.
+type LogHighlights struct {
+	Highlights LogHighlightSpec `yaml:"logHighlights"`
+}
+
+// LogHighlightSpec describes presets and custom highlight rules.
+type LogHighlightSpec struct {
+	Presets []string       `yaml:"presets"`
+	Rules   []LogHighlight `yaml:"rules"`
+}
+
+// LogHighlight describes a regex to style rule. Line rules style the whole line.
+type LogHighlight struct {
+	Pattern string `yaml:"pattern"`
+	Style   string `yaml:"style"`
+	Line    bool   `yaml:"line"`
+}
+
+// NewLogHighlights returns a new instance.
+func NewLogHighlights() *LogHighlights {
+	return &LogHighlights{}
+}
+
+// Load loads the global log highlights followed by the context specific ones.
+// Context rules take precedence over global rules.
+func (l *LogHighlights) Load(path string) error {
+	if err := l.LoadLogHighlights(AppLogHighlightsFile); err != nil {
+		return err
+	}
+	if path == "" {
+		return nil
+	}
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	return l.LoadLogHighlights(path)
+}
+
+// LoadLogHighlights loads log highlights from a given file.
+func (l *LogHighlights) LoadLogHighlights(path string) error {
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := data.JSONValidator.Validate(json.LogHighlightsSchema, bb); err != nil {
+		slog.Warn("Validation failed. Please update your config and restart.",
+			slogs.Path, path,
+			slogs.Error, err,
+		)
+	}
+
+	var hh LogHighlights
+	if err := yaml.Unmarshal(bb, &hh); err != nil {
+		return err
+	}
+	for _, p := range hh.Highlights.Presets {
+		if !slices.Contains(l.Highlights.Presets, p) {
+			l.Highlights.Presets = append(l.Highlights.Presets, p)
+		}
+	}
+	l.Highlights.Rules = append(hh.Highlights.Rules, l.Highlights.Rules...)
+
+	return nil
+}
+
+// Rules returns custom rules followed by the rules of the active presets.
+// Skin color names ie error, warn, info and debug are resolved using the given colors.
+func (l *LogHighlights) Rules(cc LogHighlightColors) ([]LogHighlight, error) {
+	var errs error
+	rr := slices.Clone(l.Highlights.Rules)
+	for _, p := range l.Highlights.Presets {
+		pp, ok := LogHighlightPresets[p]
+		if !ok {
+			errs = errors.Join(errs, fmt.Errorf("unknown log highlights preset %q", p))
+			continue
+		}
+		rr = append(rr, pp...)
+	}
+	cc = cc.withDefaults()
+	for i := range rr {
+		rr[i].Style = cc.resolve(rr[i].Style)
+	}
+
+	return rr, errs
+}
+
+// withDefaults returns the colors with unset colors set to their defaults.
+func (l LogHighlightColors) withDefaults() LogHighlightColors {
+	d := newLogHighlightColors()
+	if l.ErrorColor == "" {
+		l.ErrorColor = d.ErrorColor
+	}
+	if l.WarnColor == "" {
+		l.WarnColor = d.WarnColor
+	}
+	if l.InfoColor == "" {
+		l.InfoColor = d.InfoColor
+	}
+	if l.DebugColor == "" {
+		l.DebugColor = d.DebugColor
+	}
+
+	return l
+}
+
+// resolve substitutes a skin color name in a style foreground.
+func (l LogHighlightColors) resolve(style string) string {
+	fg, rest, ok := strings.Cut(style, ":")
+	var c Color
+	switch fg {
+	case HighlightError:
+		c = l.ErrorColor
+	case HighlightWarn:
+		c = l.WarnColor
+	case HighlightInfo:
+		c = l.InfoColor
+	case HighlightDebug:
+		c = l.DebugColor
+	default:
+		return style
+	}
+	if !ok {
+		return string(c)
+	}
+
+	return string(c) + ":" + rest
+}
@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogHighlightsLoad(t *testing.T) {
+	h := config.NewLogHighlights()
+	require.NoError(t, h.LoadLogHighlights("testdata/log_highlights/log_highlights.yaml"))
+	assert.Equal(t, []string{"levels", "bozo"}, h.Highlights.Presets)
+	assert.Len(t, h.Highlights.Rules, 2)
+	assert.Equal(t, config.LogHighlight{Pattern: "^panic:", Style: "red::b", Line: true}, h.Highlights.Rules[1])
+
+	rr, err := h.Rules(config.LogHighlightColors{ErrorColor: "fuchsia", WarnColor: "yellow"})
+	require.EqualError(t, err, `unknown log highlights preset "bozo"`)
+	assert.Len(t, rr, 2+len(config.LogHighlightPresets["levels"]))
+	assert.Equal(t, "(?i)timeout", rr[0].Pattern)
+	assert.Equal(t, "red::b", rr[1].Style)
+	assert.Equal(t, "fuchsia::b", rr[2].Style)
+	assert.Equal(t, "yellow::b", rr[3].Style)
+}
@@ -15,18 +15,53 @@ const (
 
 	// DefaultLogBufferSize is the channel buffer for log streaming.
 	DefaultLogBufferSize = 50
+
+	// DefaultRecorderMaxSizeMB tracks the max size of a recorded log file before rotation.
+	DefaultRecorderMaxSizeMB = 10
+
+	// DefaultRecorderMaxBackups tracks the number of rotated log files to keep.
+	DefaultRecorderMaxBackups = 5
 )
 
+// LogRecorder tracks log recording options.
+type LogRecorder struct {
+	MaxSizeMB  int  `json:"maxSizeMB" yaml:"maxSizeMB"`
+	MaxBackups int  `json:"maxBackups" yaml:"maxBackups"`
+	Compress   bool `json:"compress" yaml:"compress"`
+}
+
+// NewLogRecorder returns a new instance.
+func NewLogRecorder() LogRecorder {
+	return LogRecorder{
+		MaxSizeMB:  DefaultRecorderMaxSizeMB,
+		MaxBackups: DefaultRecorderMaxBackups,
+	}
+}
+
+// Validate checks thresholds and make sure we're cool. If not use defaults.
+func (r LogRecorder) Validate() LogRecorder {
+	if r.MaxSizeMB <= 0 {
+		r.MaxSizeMB = DefaultRecorderMaxSizeMB
+	}
+	if r.MaxBackups < 0 {
+		r.MaxBackups = DefaultRecorderMaxBackups
+	}
+
+	return r
+}
+
 // Logger tracks logger options.
 type Logger struct {
-	TailCount         int64 `json:"tail" yaml:"tail"`
-	BufferSize        int   `json:"buffer" yaml:"buffer"`
-	SinceSeconds      int64 `json:"sinceSeconds" yaml:"sinceSeconds"`
-	TextWrap          bool  `json:"textWrap" yaml:"textWrap"`
-	DisableAutoscroll bool  `json:"disableAutoscroll" yaml:"disableAutoscroll"`
-	ColumnLock        bool  `json:"columnLock" yaml:"columnLock"`
-	ShowTime          bool  `json:"showTime" yaml:"showTime"`
-	LogBufferSize     int   `json:"logBufferSize" yaml:"logBufferSize"`
+	TailCount         int64       `json:"tail" yaml:"tail"`
+	BufferSize        int         `json:"buffer" yaml:"buffer"`
+	SinceSeconds      int64       `json:"sinceSeconds" yaml:"sinceSeconds"`
+	TextWrap          bool        `json:"textWrap" yaml:"textWrap"`
+	DisableAutoscroll bool        `json:"disableAutoscroll" yaml:"disableAutoscroll"`
+	ColumnLock        bool        `json:"columnLock" yaml:"columnLock"`
+	ShowTime          bool        `json:"showTime" yaml:"showTime"`
+	LogBufferSize     int         `json:"logBufferSize" yaml:"logBufferSize"`
+	Structured        bool        `json:"structured" yaml:"structured"`
+	Recorder          LogRecorder `json:"recorder" yaml:"recorder"`
 }
 
 // NewLogger returns a new instance.
@@ -36,6 +71,7 @@ func NewLogger() Logger {
 		BufferSize:    MaxLogThreshold,
 		SinceSeconds:  DefaultSinceSeconds,
 		LogBufferSize: DefaultLogBufferSize,
+		Recorder:      NewLogRecorder(),
 	}
 }
 
@@ -56,6 +92,7 @@ func (l Logger) Validate() Logger {
 	if l.LogBufferSize <= 0 {
 		l.LogBufferSize = DefaultLogBufferSize
 	}
+	l.Recorder = l.Recorder.Validate()
 
 	return l
 }
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/derailed/k9s/internal/slogs"
+)
+
+const (
+	// DefaultMetricsRetention tracks how long metrics history is kept on disk.
+	DefaultMetricsRetention = 24 * time.Hour
+
+	defaultMetricsRetentionSpec = "24h"
+)
+
+// MetricsHistory tracks on-disk metrics history options.
+type MetricsHistory struct {
+	Enable    bool   `json:"enable" yaml:"enable"`
+	Retention string `json:"retention" yaml:"retention"`
+}
+
+// NewMetricsHistory returns a new instance.
+func NewMetricsHistory() MetricsHistory {
+	return MetricsHistory{
+		Retention: defaultMetricsRetentionSpec,
+	}
+}
+
+// Validate checks thresholds and make sure we're cool. If not use defaults.
+func (m MetricsHistory) Validate() MetricsHistory {
+	if d, err := time.ParseDuration(m.Retention); err != nil || d <= 0 {
+		m.Retention = defaultMetricsRetentionSpec
+	}
+
+	return m
+}
+
+// RetentionDuration returns the metrics retention as a duration.
+func (m MetricsHistory) RetentionDuration() time.Duration {
+	d, err := time.ParseDuration(m.Retention)
+	if err != nil || d <= 0 {
+		slog.Warn("Invalid metrics history retention. Using default",
+			slogs.Duration, m.Retention,
+			slogs.Error, err,
+		)
+		return DefaultMetricsRetention
+	}
+
+	return d
+}
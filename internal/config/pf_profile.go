@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"slices"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/config/json"
+	"github.com/derailed/k9s/internal/port"
+	"github.com/derailed/k9s/internal/slogs"
+	"gopkg.in/yaml.v3"
+)
+
+// PFProfiles represents a collection of named port-forward profiles.
+type PFProfiles struct {
+	Profiles []PFProfile `yaml:"portForwardProfiles"`
+}
+
+// PFProfile represents a named group of port-forwards.
+type PFProfile struct {
+	Name      string             `yaml:"name"`
+	AutoStart bool               `yaml:"autoStart,omitempty"`
+	Forwards  []PFProfileForward `yaml:"forwards,omitempty"`
+}
+
+// PFProfileForward describes a port-forward target. Targets may be pods or
+// any resource that resolves to a pod ie deployments, statefulsets, services...
+type PFProfileForward struct {
+	GVR           string `yaml:"gvr,omitempty"`
+	Path          string `yaml:"path"`
+	Container     string `yaml:"container,omitempty"`
+	ContainerPort string `yaml:"containerPort"`
+	LocalPort     string `yaml:"localPort,omitempty"`
+	Address       string `yaml:"address,omitempty"`
+}
+
+// NewPFProfiles returns a new instance.
+func NewPFProfiles() *PFProfiles {
+	return &PFProfiles{}
+}
+
+// TargetGVR returns the forward target resource. Defaults to pods.
+func (f PFProfileForward) TargetGVR() *client.GVR {
+	if f.GVR == "" {
+		return client.PodGVR
+	}
+
+	return client.NewGVR(f.GVR)
+}
+
+// Tunnel returns a port tunnel for this forward using the given default address.
+func (f PFProfileForward) Tunnel(address string) port.PortTunnel {
+	if f.Address != "" {
+		address = f.Address
+	}
+	lp := f.LocalPort
+	if lp == "" {
+		lp = f.ContainerPort
+	}
+
+	return port.NewPortTunnel(address, f.Container, lp, f.ContainerPort)
+}
+
+// Load loads port-forward profiles from a given file.
+func (p *PFProfiles) Load(path string) error {
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := data.JSONValidator.Validate(json.PFProfilesSchema, bb); err != nil {
+		slog.Warn("Validation failed. Please update your config and restart.",
+			slogs.Path, path,
+			slogs.Error, err,
+		)
+	}
+
+	return yaml.Unmarshal(bb, p)
+}
+
+// Save saves port-forward profiles to a given file.
+func (p *PFProfiles) Save(path string) error {
+	if path == "" {
+		return errors.New("no port-forward profiles file specified")
+	}
+	if err := data.EnsureDirPath(path, data.DefaultDirMod); err != nil {
+		return err
+	}
+
+	return data.SaveYAML(path, p)
+}
+
+// Get returns a named profile.
+func (p *PFProfiles) Get(name string) (PFProfile, bool) {
+	if idx := p.indexOf(name); idx >= 0 {
+		return p.Profiles[idx], true
+	}
+
+	return PFProfile{}, false
+}
+
+// Add adds forwards to a named profile. Forwards on the same local address
+// are replaced. The profile is created if it does not exist.
+func (p *PFProfiles) Add(name string, ff ...PFProfileForward) {
+	idx := p.indexOf(name)
+	if idx < 0 {
+		p.Profiles = append(p.Profiles, PFProfile{Name: name})
+		idx = len(p.Profiles) - 1
+	}
+	pr := &p.Profiles[idx]
+	for _, f := range ff {
+		pr.Forwards = slices.DeleteFunc(pr.Forwards, func(o PFProfileForward) bool {
+			return o.Address == f.Address && o.Tunnel("").LocalPort == f.Tunnel("").LocalPort
+		})
+		pr.Forwards = append(pr.Forwards, f)
+	}
+}
+
+// Delete removes a named profile.
+func (p *PFProfiles) Delete(name string) error {
+	idx := p.indexOf(name)
+	if idx < 0 {
+		return fmt.Errorf("no port-forward profile named %q", name)
+	}
+	p.Profiles = slices.Delete(p.Profiles, idx, idx+1)
+
+	return nil
+}
+
+// ToggleAutoStart toggles a named profile auto start.
+func (p *PFProfiles) ToggleAutoStart(name string) (bool, error) {
+	idx := p.indexOf(name)
+	if idx < 0 {
+		return false, fmt.Errorf("no port-forward profile named %q", name)
+	}
+	p.Profiles[idx].AutoStart = !p.Profiles[idx].AutoStart
+
+	return p.Profiles[idx].AutoStart, nil
+}
+
+func (p *PFProfiles) indexOf(name string) int {
+	return slices.IndexFunc(p.Profiles, func(pr PFProfile) bool {
+		return pr.Name == name
+	})
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/port"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPFProfilesLoad(t *testing.T) {
+	p := config.NewPFProfiles()
+	require.NoError(t, p.Load("testdata/pf_profiles/pf_profiles.yaml"))
+	assert.Len(t, p.Profiles, 2)
+
+	pr, ok := p.Get("morning")
+	require.True(t, ok)
+	assert.True(t, pr.AutoStart)
+	require.Len(t, pr.Forwards, 2)
+	assert.Equal(t, client.DpGVR, pr.Forwards[0].TargetGVR())
+	assert.Equal(t, client.PodGVR, pr.Forwards[1].TargetGVR())
+	assert.Equal(t, port.NewPortTunnel("localhost", "blee", "9090", "8080"), pr.Forwards[0].Tunnel("localhost"))
+	assert.Equal(t, port.NewPortTunnel("0.0.0.0", "zorg", "5432", "5432"), pr.Forwards[1].Tunnel("localhost"))
+
+	_, ok = p.Get("bozo")
+	assert.False(t, ok)
+}
+
+func TestPFProfilesSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "c1", "pf_profiles.yaml")
+	p := config.NewPFProfiles()
+	p.Add("dev",
+		config.PFProfileForward{Path: "fred/p1", Container: "c1", ContainerPort: "80", LocalPort: "8080"},
+		config.PFProfileForward{Path: "fred/p2", Container: "c2", ContainerPort: "81"},
+	)
+	p.Add("dev", config.PFProfileForward{Path: "fred/p3", Container: "c3", ContainerPort: "82", LocalPort: "8080"})
+	on, err := p.ToggleAutoStart("dev")
+	require.NoError(t, err)
+	assert.True(t, on)
+	require.NoError(t, p.Save(path))
+
+	p1 := config.NewPFProfiles()
+	require.NoError(t, p1.Load(path))
+	assert.Equal(t, p, p1)
+	assert.Equal(t, "fred/p3", p1.Profiles[0].Forwards[1].Path)
+
+	require.NoError(t, p1.Delete("dev"))
+	assert.Empty(t, p1.Profiles)
+	require.Error(t, p1.Delete("dev"))
+}
@@ -3,6 +3,15 @@
 
 package config
 
+import (
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/slogs"
+)
+
 // Labels tracks a collection of labels.
 type Labels map[string][]string
 
@@ -48,10 +57,152 @@ func (b ScanExcludes) exclude(ns string, ll map[string]string) bool {
 	return false
 }
 
+// ScanRule tracks vulnerability budgets for workloads matching given
+// namespaces or labels. A rule with no namespaces nor labels matches all workloads.
+type ScanRule struct {
+	Namespaces  []string `json:"namespaces,omitempty" yaml:"namespaces,omitempty"`
+	Labels      Labels   `json:"labels,omitempty" yaml:"labels,omitempty"`
+	MaxCritical *int     `json:"maxCritical,omitempty" yaml:"maxCritical,omitempty"`
+	MaxHigh     *int     `json:"maxHigh,omitempty" yaml:"maxHigh,omitempty"`
+	FixableOnly bool     `json:"fixableOnly,omitempty" yaml:"fixableOnly,omitempty"`
+}
+
+func (r ScanRule) matches(ns string, ll map[string]string) bool {
+	if len(r.Namespaces) == 0 && len(r.Labels) == 0 {
+		return true
+	}
+	if slices.Contains(r.Namespaces, ns) {
+		return true
+	}
+	for k, v := range ll {
+		if r.Labels.exclude(k, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AcceptedCVE tracks a vulnerability accepted until a given date.
+type AcceptedCVE struct {
+	ID      string `json:"id" yaml:"id"`
+	Expires string `json:"expires" yaml:"expires"`
+	Reason  string `json:"reason,omitempty" yaml:"reason,omitempty"`
+
+	expiry time.Time
+}
+
+// Validate parses the acceptance expiry date.
+func (a AcceptedCVE) Validate() (AcceptedCVE, error) {
+	exp, err := time.Parse(time.DateOnly, a.Expires)
+	if err != nil {
+		return a, err
+	}
+	a.expiry = exp
+
+	return a, nil
+}
+
+// IsActive checks if the acceptance holds at a given time. Acceptances hold
+// through their expiry date.
+func (a AcceptedCVE) IsActive(now time.Time) bool {
+	exp := a.expiry
+	if exp.IsZero() {
+		var err error
+		if exp, err = time.Parse(time.DateOnly, a.Expires); err != nil {
+			return false
+		}
+	}
+
+	return now.Before(time.Date(exp.Year(), exp.Month(), exp.Day()+1, 0, 0, 0, 0, now.Location()))
+}
+
+// ScanPolicy tracks vulnerability budgets and accepted vulnerabilities.
+type ScanPolicy struct {
+	Rules     []ScanRule    `json:"rules,omitempty" yaml:"rules,omitempty"`
+	Allowlist []AcceptedCVE `json:"allowlist,omitempty" yaml:"allowlist,omitempty"`
+}
+
+// Validate checks the policy acceptances and skips the ones with an invalid expiry.
+func (p ScanPolicy) Validate() ScanPolicy {
+	if len(p.Allowlist) == 0 {
+		return p
+	}
+	aa := make([]AcceptedCVE, 0, len(p.Allowlist))
+	for _, a := range p.Allowlist {
+		a, err := a.Validate()
+		if err != nil {
+			slog.Warn("Invalid CVE acceptance expiry. Skipping!", slogs.ID, a.ID, slogs.Error, err)
+			continue
+		}
+		aa = append(aa, a)
+	}
+	p.Allowlist = aa
+
+	return p
+}
+
+// RuleFor returns the first rule matching a given namespace and labels.
+func (p ScanPolicy) RuleFor(ns string, ll map[string]string) (ScanRule, bool) {
+	for _, r := range p.Rules {
+		if r.matches(ns, ll) {
+			return r, true
+		}
+	}
+
+	return ScanRule{}, false
+}
+
+// IsAccepted checks if a given vulnerability is accepted at a given time.
+func (p ScanPolicy) IsAccepted(id string, now time.Time) bool {
+	for _, a := range p.Allowlist {
+		if strings.EqualFold(a.ID, id) && a.IsActive(now) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ScanDB tracks vulnerability database options.
+type ScanDB struct {
+	// Archive specifies a local database archive imported when newer than the installed database.
+	Archive string `json:"archive,omitempty" yaml:"archive,omitempty"`
+
+	// UpdateURL specifies a custom database listing URL.
+	UpdateURL string `json:"updateURL,omitempty" yaml:"updateURL,omitempty"`
+
+	// MaxAge specifies how old the database may get before it is considered stale.
+	MaxAge string `json:"maxAge,omitempty" yaml:"maxAge,omitempty"`
+
+	// NoUpdate disables database update checks.
+	NoUpdate bool `json:"noUpdate,omitempty" yaml:"noUpdate,omitempty"`
+}
+
+// MaxAgeDuration returns the database max age. Returns ok=false when
+// unset or invalid.
+func (d ScanDB) MaxAgeDuration() (time.Duration, bool) {
+	if d.MaxAge == "" {
+		return 0, false
+	}
+	age, err := time.ParseDuration(d.MaxAge)
+	if err != nil || age <= 0 {
+		slog.Warn("Invalid vulnerability db max age. Using default",
+			slogs.Duration, d.MaxAge,
+			slogs.Error, err,
+		)
+		return 0, false
+	}
+
+	return age, true
+}
+
 // ImageScans tracks vul scans options.
 type ImageScans struct {
 	Enable     bool         `json:"enable" yaml:"enable"`
 	Exclusions ScanExcludes `json:"exclusions" yaml:"exclusions"`
+	Policy     ScanPolicy   `json:"policy,omitempty" yaml:"policy,omitempty"`
+	DB         ScanDB       `json:"db,omitempty" yaml:"db,omitempty"`
 }
 
 // NewImageScans returns a new instance.
@@ -61,6 +212,13 @@ func NewImageScans() ImageScans {
 	}
 }
 
+// Validate validates the scans configuration.
+func (i ImageScans) Validate() ImageScans {
+	i.Policy = i.Policy.Validate()
+
+	return i
+}
+
 // ShouldExclude checks if scan should be excluded given ns/labels
 func (i ImageScans) ShouldExclude(ns string, ll map[string]string) bool {
 	if !i.Enable {
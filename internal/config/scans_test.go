@@ -5,9 +5,12 @@ package config_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/config"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestScansShouldExclude(t *testing.T) {
@@ -91,3 +94,152 @@ func TestScansShouldExclude(t *testing.T) {
 		})
 	}
 }
+
+func TestScanPolicyRuleFor(t *testing.T) {
+	zero, five := 0, 5
+	p := config.ScanPolicy{
+		Rules: []config.ScanRule{
+			{Namespaces: []string{"prod"}, MaxCritical: &zero, MaxHigh: &five},
+			{Labels: config.Labels{"tier": []string{"frontend"}}, MaxCritical: &zero, FixableOnly: true},
+			{MaxCritical: &five},
+		},
+	}
+
+	uu := map[string]struct {
+		p  config.ScanPolicy
+		ns string
+		ll map[string]string
+		e  int
+		ok bool
+	}{
+		"none": {
+			ns: "prod",
+			e:  -1,
+		},
+		"ns": {
+			p:  p,
+			ns: "prod",
+			ll: map[string]string{"tier": "frontend"},
+			e:  0,
+			ok: true,
+		},
+		"labels": {
+			p:  p,
+			ns: "dev",
+			ll: map[string]string{"tier": "frontend"},
+			e:  1,
+			ok: true,
+		},
+		"catch-all": {
+			p:  p,
+			ns: "dev",
+			ll: map[string]string{"tier": "backend"},
+			e:  2,
+			ok: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r, ok := u.p.RuleFor(u.ns, u.ll)
+			assert.Equal(t, u.ok, ok)
+			if u.ok {
+				assert.Equal(t, u.p.Rules[u.e], r)
+			}
+		})
+	}
+}
+
+func TestScanPolicyIsAccepted(t *testing.T) {
+	var p config.ScanPolicy
+	require.NoError(t, yaml.Unmarshal([]byte(`
+allowlist:
+  - id: CVE-2023-1234
+    expires: 2026-10-20
+    reason: No exploit path
+  - id: GHSA-xxxx
+    expires: fred
+`), &p))
+	now := time.Date(2026, 10, 20, 23, 0, 0, 0, time.UTC)
+
+	uu := map[string]struct {
+		id  string
+		now time.Time
+		e   bool
+	}{
+		"accepted": {
+			id:  "CVE-2023-1234",
+			now: now,
+			e:   true,
+		},
+		"case": {
+			id:  "cve-2023-1234",
+			now: now,
+			e:   true,
+		},
+		"expired": {
+			id:  "CVE-2023-1234",
+			now: now.Add(2 * time.Hour),
+		},
+		"invalid-expiry": {
+			id:  "GHSA-xxxx",
+			now: now,
+		},
+		"unknown": {
+			id:  "CVE-2024-0001",
+			now: now,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, p.IsAccepted(u.id, u.now))
+			assert.Equal(t, u.e, p.Validate().IsAccepted(u.id, u.now))
+		})
+	}
+}
+
+func TestScanDBMaxAgeDuration(t *testing.T) {
+	uu := map[string]struct {
+		age string
+		d   time.Duration
+		ok  bool
+	}{
+		"unset": {},
+		"valid": {
+			age: "720h",
+			d:   720 * time.Hour,
+			ok:  true,
+		},
+		"negative": {
+			age: "-1h",
+		},
+		"invalid": {
+			age: "1month",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			d, ok := config.ScanDB{MaxAge: u.age}.MaxAgeDuration()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.d, d)
+		})
+	}
+}
+
+func TestScanPolicyValidate(t *testing.T) {
+	p := config.ScanPolicy{
+		Allowlist: []config.AcceptedCVE{
+			{ID: "CVE-1", Expires: "2026-10-20"},
+			{ID: "CVE-2", Expires: "fred"},
+		},
+	}.Validate()
+
+	require.Len(t, p.Allowlist, 1)
+	assert.Equal(t, "CVE-1", p.Allowlist[0].ID)
+	assert.Empty(t, config.ScanPolicy{}.Validate().Allowlist)
+}
@@ -127,6 +127,7 @@ type (
 		Xray   Xray   `json:"xray" yaml:"xray"`
 		Charts Charts `json:"charts" yaml:"charts"`
 		Yaml   Yaml   `json:"yaml" yaml:"yaml"`
+		Diff   Diff   `json:"diff" yaml:"diff"`
 		Picker Picker `json:"picker" yaml:"picker"`
 		Log    Log    `json:"logs" yaml:"logs"`
 	}
@@ -145,9 +146,10 @@ type (
 
 	// Log tracks Log styles.
 	Log struct {
-		FgColor   Color        `json:"fgColor" yaml:"fgColor"`
-		BgColor   Color        `json:"bgColor" yaml:"bgColor"`
-		Indicator LogIndicator `json:"indicator" yaml:"indicator"`
+		FgColor   Color              `json:"fgColor" yaml:"fgColor"`
+		BgColor   Color              `json:"bgColor" yaml:"bgColor"`
+		Indicator LogIndicator       `json:"indicator" yaml:"indicator"`
+		Highlight LogHighlightColors `json:"highlight" yaml:"highlight"`
 	}
 
 	// Picker tracks color when selecting containers
@@ -165,6 +167,14 @@ type (
 		ToggleOffColor Color `json:"toggleOffColor" yaml:"toggleOffColor"`
 	}
 
+	// LogHighlightColors tracks log highlights colors.
+	LogHighlightColors struct {
+		ErrorColor Color `json:"errorColor" yaml:"errorColor"`
+		WarnColor  Color `json:"warnColor" yaml:"warnColor"`
+		InfoColor  Color `json:"infoColor" yaml:"infoColor"`
+		DebugColor Color `json:"debugColor" yaml:"debugColor"`
+	}
+
 	// Yaml tracks yaml styles.
 	Yaml struct {
 		KeyColor   Color `json:"keyColor" yaml:"keyColor"`
@@ -172,6 +182,14 @@ type (
 		ColonColor Color `json:"colonColor" yaml:"colonColor"`
 	}
 
+	// Diff tracks diff styles.
+	Diff struct {
+		HeaderColor Color `json:"headerColor" yaml:"headerColor"`
+		HunkColor   Color `json:"hunkColor" yaml:"hunkColor"`
+		AddColor    Color `json:"addColor" yaml:"addColor"`
+		DelColor    Color `json:"delColor" yaml:"delColor"`
+	}
+
 	// Title tracks title styles.
 	Title struct {
 		FgColor        Color `json:"fgColor" yaml:"fgColor"`
@@ -310,6 +328,7 @@ func newViews() Views {
 		Xray:   newXray(),
 		Charts: newCharts(),
 		Yaml:   newYaml(),
+		Diff:   newDiff(),
 		Picker: newPicker(),
 		Log:    newLog(),
 	}
@@ -373,6 +392,16 @@ func newLog() Log {
 		FgColor:   "lightskyblue",
 		BgColor:   "black",
 		Indicator: newLogIndicator(),
+		Highlight: newLogHighlightColors(),
+	}
+}
+
+func newLogHighlightColors() LogHighlightColors {
+	return LogHighlightColors{
+		ErrorColor: "red",
+		WarnColor:  "orange",
+		InfoColor:  "green",
+		DebugColor: "gray",
 	}
 }
 
@@ -393,6 +422,15 @@ func newYaml() Yaml {
 	}
 }
 
+func newDiff() Diff {
+	return Diff{
+		HeaderColor: "white",
+		HunkColor:   "aqua",
+		AddColor:    "green",
+		DelColor:    "red",
+	}
+}
+
 func newTitle() Title {
 	return Title{
 		FgColor:        "aqua",
@@ -696,6 +734,7 @@ func (v *Views) Invert() {
 	v.Xray.Invert()
 	v.Charts.Invert()
 	v.Yaml.Invert()
+	v.Diff.Invert()
 	v.Picker.Invert()
 	v.Log.Invert()
 }
@@ -748,6 +787,14 @@ func (y *Yaml) Invert() {
 	y.ColonColor = y.ColonColor.InvertColor()
 }
 
+// Invert inverts all colors in Diff.
+func (d *Diff) Invert() {
+	d.HeaderColor = d.HeaderColor.InvertColor()
+	d.HunkColor = d.HunkColor.InvertColor()
+	d.AddColor = d.AddColor.InvertColor()
+	d.DelColor = d.DelColor.InvertColor()
+}
+
 // Invert inverts all colors in Picker.
 func (p *Picker) Invert() {
 	p.MainColor = p.MainColor.InvertColor()
@@ -760,6 +807,15 @@ func (l *Log) Invert() {
 	l.FgColor = l.FgColor.InvertColor()
 	l.BgColor = l.BgColor.InvertColor()
 	l.Indicator.Invert()
+	l.Highlight.Invert()
+}
+
+// Invert inverts all colors in LogHighlightColors.
+func (l *LogHighlightColors) Invert() {
+	l.ErrorColor = l.ErrorColor.InvertColor()
+	l.WarnColor = l.WarnColor.InvertColor()
+	l.InfoColor = l.InfoColor.InvertColor()
+	l.DebugColor = l.DebugColor.InvertColor()
 }
 
 // Invert inverts all colors in LogIndicator.
@@ -174,6 +174,20 @@ func (v *CustomView) fireConfigChanged() {
 	}
 }
 
+// ViewSettingFor returns the first view setting matching the given commands if any.
+func (v *CustomView) ViewSettingFor(ns string, cmds ...string) *ViewSetting {
+	for _, cmd := range cmds {
+		if cmd == "" {
+			continue
+		}
+		if vs := v.getVS(cmd, ns); vs != nil {
+			return vs
+		}
+	}
+
+	return nil
+}
+
 func (v *CustomView) getVS(gvr, ns string) *ViewSetting {
 	if client.IsAllNamespaces(ns) {
 		ns = client.NamespaceAll
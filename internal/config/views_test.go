@@ -49,6 +49,45 @@ func TestCustomViewLoad(t *testing.T) {
 	}
 }
 
+func TestCustomViewSettingFor(t *testing.T) {
+	uu := map[string]struct {
+		ns   string
+		cmds []string
+		e    []string
+	}{
+		"none": {},
+
+		"no-match": {
+			cmds: []string{"fred", "blee"},
+		},
+
+		"gvr": {
+			ns:   "fred",
+			cmds: []string{client.PodGVR.String()},
+			e:    []string{"NAMESPACE", "NAME", "AGE", "IP"},
+		},
+
+		"first-match": {
+			ns:   "default",
+			cmds: []string{"", "zorg", client.PodGVR.String(), "bozo"},
+			e:    []string{"NAME", "IP", "AGE"},
+		},
+	}
+
+	cfg := config.NewCustomView()
+	require.NoError(t, cfg.Load("testdata/views/views.yaml"))
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			vs := cfg.ViewSettingFor(u.ns, u.cmds...)
+			if u.e == nil {
+				assert.Nil(t, vs)
+				return
+			}
+			assert.Equal(t, u.e, vs.Columns)
+		})
+	}
+}
+
 func TestViewSettingEquals(t *testing.T) {
 	uu := map[string]struct {
 		v1, v2 *config.ViewSetting
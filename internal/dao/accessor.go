@@ -15,7 +15,11 @@ var accessors = Accessors{
 	client.SdGVR:  new(ScreenDump),
 	client.BeGVR:  new(Benchmark),
 	client.PfGVR:  new(PortForward),
+	client.PfpGVR: new(PFProfile),
 	client.DirGVR: new(Dir),
+	client.LpGVR:  new(LogPattern),
+	client.AlGVR:  new(Alert),
+	client.VdbGVR: new(VulDB),
 
 	client.SvcGVR:  new(Service),
 	client.PodGVR:  new(Pod),
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Alert)(nil)
+
+// Alert represents alert notifications.
+type Alert struct {
+	NonResource
+}
+
+// NewAlert returns a new alerts accessor.
+func NewAlert(f Factory) *Alert {
+	var a Alert
+	a.Init(f, client.AlGVR)
+
+	return &a
+}
+
+// List returns a collection of alert notifications.
+func (*Alert) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	ac, ok := ctx.Value(internal.KeyAlertCenter).(*AlertCenter)
+	if !ok {
+		return nil, errors.New("no alert center in context")
+	}
+
+	aa := ac.Notifications()
+	oo := make([]runtime.Object, 0, len(aa))
+	for _, a := range aa {
+		oo = append(oo, a)
+	}
+
+	return oo, nil
+}
+
+// Get fetch a resource.
+func (*Alert) Get(_ context.Context, _ string) (runtime.Object, error) {
+	return nil, errors.New("nyi")
+}
@@ -0,0 +1,582 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	v1 "k8s.io/api/core/v1"
+	evv1 "k8s.io/api/events/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// AlertEvalRate represents the alert rules evaluation rate.
+	AlertEvalRate = 30 * time.Second
+
+	// MaxAlerts represents the max number of retained notifications.
+	MaxAlerts = 500
+
+	defaultPVCUsageThreshold = 80
+)
+
+// alertKinds tracks event involved objects kinds that can be navigated to.
+var alertKinds = map[string]*client.GVR{
+	"Pod":                   client.PodGVR,
+	"Node":                  client.NodeGVR,
+	"Deployment":            client.DpGVR,
+	"ReplicaSet":            client.RsGVR,
+	"StatefulSet":           client.StsGVR,
+	"DaemonSet":             client.DsGVR,
+	"Job":                   client.JobGVR,
+	"CronJob":               client.CjGVR,
+	"Service":               client.SvcGVR,
+	"PersistentVolumeClaim": client.PvcGVR,
+}
+
+// AlertListener represents an alert notifications listener.
+type AlertListener interface {
+	// AlertFired notifies a new alert fired.
+	AlertFired(render.AlertRes)
+}
+
+// AlertHit represents a rule violation for a given resource.
+type AlertHit struct {
+	GVR     *client.GVR
+	Path    string
+	Message string
+}
+
+type restartSample struct {
+	at    time.Time
+	count int
+}
+
+// AlertCenter evaluates alert rules and tracks notifications.
+type AlertCenter struct {
+	factory   Factory
+	rules     []config.AlertRule
+	alerts    map[string]*render.AlertRes
+	restarts  map[string]map[string][]restartSample
+	listeners []AlertListener
+	mx        sync.RWMutex
+}
+
+// NewAlertCenter returns a new alert center.
+func NewAlertCenter(f Factory, rr []config.AlertRule) *AlertCenter {
+	return &AlertCenter{
+		factory:  f,
+		rules:    rr,
+		alerts:   make(map[string]*render.AlertRes),
+		restarts: make(map[string]map[string][]restartSample),
+	}
+}
+
+// HasRules checks if any alert rules are configured.
+func (a *AlertCenter) HasRules() bool {
+	return len(a.rules) > 0
+}
+
+// AddListener registers a new alert listener.
+func (a *AlertCenter) AddListener(l AlertListener) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	a.listeners = append(a.listeners, l)
+}
+
+// Watch evaluates alert rules until the context is canceled.
+func (a *AlertCenter) Watch(ctx context.Context) {
+	if !a.HasRules() {
+		return
+	}
+	go func() {
+		a.Evaluate(ctx, time.Now())
+		ticker := time.NewTicker(AlertEvalRate)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case t := <-ticker.C:
+				a.Evaluate(ctx, t)
+			}
+		}
+	}()
+}
+
+// Evaluate evaluates all rules at a given time.
+func (a *AlertCenter) Evaluate(ctx context.Context, now time.Time) {
+	for _, r := range a.rules {
+		hh, err := a.evalRule(ctx, r, now)
+		if err != nil {
+			slog.Warn("Alert rule evaluation failed",
+				slogs.Name, r.Name,
+				slogs.Error, err,
+			)
+			continue
+		}
+		a.Update(r, hh, now)
+	}
+}
+
+// Update records the rule hits. Resources no longer in violation are resolved.
+func (a *AlertCenter) Update(r config.AlertRule, hh []AlertHit, now time.Time) {
+	a.mx.Lock()
+	fired := make([]render.AlertRes, 0, len(hh))
+	seen := make(map[string]struct{}, len(hh))
+	for _, h := range hh {
+		id := r.Name + "|" + h.Path
+		seen[id] = struct{}{}
+		al, ok := a.alerts[id]
+		if !ok {
+			al = &render.AlertRes{
+				ID:       id,
+				Rule:     r.Name,
+				Kind:     r.Kind,
+				Severity: r.SeverityOrDefault(),
+				GVR:      h.GVR,
+				Path:     h.Path,
+			}
+			a.alerts[id] = al
+		}
+		if !al.Active {
+			al.Active, al.Acked = true, false
+			al.Count++
+			if al.FirstSeen.IsZero() {
+				al.FirstSeen = now
+			}
+			fired = append(fired, *al)
+		}
+		al.Message, al.LastSeen = h.Message, now
+	}
+	for id, al := range a.alerts {
+		if _, ok := seen[id]; !ok && al.Rule == r.Name {
+			al.Active = false
+		}
+	}
+	a.prune()
+	ll := slices.Clone(a.listeners)
+	a.mx.Unlock()
+
+	for _, al := range fired {
+		for _, l := range ll {
+			l.AlertFired(al)
+		}
+	}
+}
+
+func (a *AlertCenter) prune() {
+	if len(a.alerts) <= MaxAlerts {
+		return
+	}
+	aa := make([]*render.AlertRes, 0, len(a.alerts))
+	for _, al := range a.alerts {
+		if !al.Active {
+			aa = append(aa, al)
+		}
+	}
+	slices.SortFunc(aa, func(a, b *render.AlertRes) int {
+		return a.LastSeen.Compare(b.LastSeen)
+	})
+	for _, al := range aa {
+		if len(a.alerts) <= MaxAlerts {
+			return
+		}
+		delete(a.alerts, al.ID)
+	}
+}
+
+// Notifications returns all tracked notifications.
+func (a *AlertCenter) Notifications() []render.AlertRes {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	aa := make([]render.AlertRes, 0, len(a.alerts))
+	for _, al := range a.alerts {
+		aa = append(aa, *al)
+	}
+
+	return aa
+}
+
+// Ack acknowledges the given notifications.
+func (a *AlertCenter) Ack(ids ...string) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	for _, id := range ids {
+		if al, ok := a.alerts[id]; ok {
+			al.Acked = true
+		}
+	}
+}
+
+// Clear removes the given notifications.
+func (a *AlertCenter) Clear(ids ...string) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	for _, id := range ids {
+		delete(a.alerts, id)
+	}
+}
+
+func (a *AlertCenter) evalRule(ctx context.Context, r config.AlertRule, now time.Time) ([]AlertHit, error) {
+	sel, err := labels.Parse(r.Selector)
+	if err != nil {
+		return nil, err
+	}
+	ns := r.Namespace
+	if ns == "" {
+		ns = client.BlankNamespace
+	}
+
+	switch r.Kind {
+	case config.AlertPodRestarts:
+		oo, err := a.factory.List(client.PodGVR, ns, true, sel)
+		if err != nil {
+			return nil, err
+		}
+		pp, err := toTyped[v1.Pod](oo)
+		if err != nil {
+			return nil, err
+		}
+		w, _ := r.WindowDuration()
+		a.mx.Lock()
+		defer a.mx.Unlock()
+		ss, ok := a.restarts[r.Name]
+		if !ok {
+			ss = make(map[string][]restartSample)
+			a.restarts[r.Name] = ss
+		}
+		return evalPodRestarts(ss, pp, r.Threshold, w, now), nil
+	case config.AlertNodeNotReady:
+		oo, err := a.factory.List(client.NodeGVR, client.ClusterScope, true, sel)
+		if err != nil {
+			return nil, err
+		}
+		nn, err := toTyped[v1.Node](oo)
+		if err != nil {
+			return nil, err
+		}
+		return evalNodesNotReady(nn), nil
+	case config.AlertPVCUsage:
+		return a.evalPVCUsage(ctx, r, ns, sel)
+	case config.AlertWarningEvent:
+		oo, err := a.factory.List(client.EvGVR, ns, true, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		ee, err := toTyped[evv1.Event](oo)
+		if err != nil {
+			return nil, err
+		}
+		w, _ := r.WindowDuration()
+		hh, err := evalWarningEvents(ee, r.Reason, r.Threshold, w, now)
+		if err != nil || sel.Empty() {
+			return hh, err
+		}
+		return a.filterHits(hh, sel), nil
+	default:
+		return nil, fmt.Errorf("unknown alert kind %q", r.Kind)
+	}
+}
+
+// filterHits retains hits which resources match the given selector.
+func (a *AlertCenter) filterHits(hh []AlertHit, sel labels.Selector) []AlertHit {
+	rr := make([]AlertHit, 0, len(hh))
+	for _, h := range hh {
+		if h.GVR == nil {
+			continue
+		}
+		o, err := a.factory.Get(h.GVR, h.Path, true, labels.Everything())
+		if err != nil {
+			continue
+		}
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok || !sel.Matches(labels.Set(u.GetLabels())) {
+			continue
+		}
+		rr = append(rr, h)
+	}
+
+	return rr
+}
+
+type pvcRef struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+type volumeStats struct {
+	Name          string  `json:"name"`
+	PVCRef        *pvcRef `json:"pvcRef"`
+	UsedBytes     *uint64 `json:"usedBytes"`
+	CapacityBytes *uint64 `json:"capacityBytes"`
+}
+
+type statsSummary struct {
+	Pods []struct {
+		Volumes []volumeStats `json:"volume"`
+	} `json:"pods"`
+}
+
+func (a *AlertCenter) evalPVCUsage(ctx context.Context, r config.AlertRule, ns string, sel labels.Selector) ([]AlertHit, error) {
+	oo, err := a.factory.List(client.PvcGVR, ns, true, sel)
+	if err != nil {
+		return nil, err
+	}
+	pvcs := make(map[string]struct{}, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		pvcs[client.FQN(u.GetNamespace(), u.GetName())] = struct{}{}
+	}
+	if len(pvcs) == 0 {
+		return nil, nil
+	}
+
+	nn, err := a.factory.List(client.NodeGVR, client.ClusterScope, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	dial, err := a.factory.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	var vv []volumeStats
+	for _, o := range nn {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		bb, err := dial.CoreV1().RESTClient().Get().
+			AbsPath("/api/v1/nodes/" + u.GetName() + "/proxy/stats/summary").
+			DoRaw(ctx)
+		if err != nil {
+			slog.Warn("Unable to fetch node stats summary",
+				slogs.ResName, u.GetName(),
+				slogs.Error, err,
+			)
+			continue
+		}
+		var s statsSummary
+		if err := json.Unmarshal(bb, &s); err != nil {
+			return nil, err
+		}
+		for _, p := range s.Pods {
+			vv = append(vv, p.Volumes...)
+		}
+	}
+
+	return evalPVCUsage(vv, pvcs, r.Threshold), nil
+}
+
+// evalPodRestarts reports pods that restarted more than threshold times within the window.
+// Restart samples are tracked in the given map across evaluations.
+func evalPodRestarts(samples map[string][]restartSample, pp []v1.Pod, threshold int, window time.Duration, now time.Time) []AlertHit {
+	hh := make([]AlertHit, 0, len(pp))
+	live := make(map[string]struct{}, len(pp))
+	for i := range pp {
+		fqn := client.FQN(pp[i].Namespace, pp[i].Name)
+		live[fqn] = struct{}{}
+		var count int
+		for _, s := range pp[i].Status.InitContainerStatuses {
+			count += int(s.RestartCount)
+		}
+		for _, s := range pp[i].Status.ContainerStatuses {
+			count += int(s.RestartCount)
+		}
+
+		ss := append(samples[fqn], restartSample{at: now, count: count})
+		// Keep the latest sample outside the window as the baseline.
+		for len(ss) > 1 && !ss[1].at.After(now.Add(-window)) {
+			ss = ss[1:]
+		}
+		samples[fqn] = ss
+		if delta := count - ss[0].count; delta > threshold {
+			hh = append(hh, AlertHit{
+				GVR:     client.PodGVR,
+				Path:    fqn,
+				Message: fmt.Sprintf("%d restarts in the last %s", delta, window),
+			})
+		}
+	}
+	for fqn := range samples {
+		if _, ok := live[fqn]; !ok {
+			delete(samples, fqn)
+		}
+	}
+
+	return hh
+}
+
+// evalNodesNotReady reports nodes which Ready condition is not true.
+func evalNodesNotReady(nn []v1.Node) []AlertHit {
+	hh := make([]AlertHit, 0, len(nn))
+	for i := range nn {
+		for _, c := range nn[i].Status.Conditions {
+			if c.Type != v1.NodeReady || c.Status == v1.ConditionTrue {
+				continue
+			}
+			msg := "Node is not ready"
+			if c.Message != "" {
+				msg = c.Message
+			}
+			hh = append(hh, AlertHit{
+				GVR:     client.NodeGVR,
+				Path:    nn[i].Name,
+				Message: msg,
+			})
+		}
+	}
+
+	return hh
+}
+
+// evalPVCUsage reports tracked volume claims which usage exceeds threshold percent.
+func evalPVCUsage(vv []volumeStats, pvcs map[string]struct{}, threshold int) []AlertHit {
+	if threshold == 0 {
+		threshold = defaultPVCUsageThreshold
+	}
+	hh := make([]AlertHit, 0, len(vv))
+	seen := make(map[string]struct{}, len(vv))
+	for _, v := range vv {
+		if v.PVCRef == nil || v.UsedBytes == nil || v.CapacityBytes == nil || *v.CapacityBytes == 0 {
+			continue
+		}
+		fqn := client.FQN(v.PVCRef.Namespace, v.PVCRef.Name)
+		if _, ok := pvcs[fqn]; !ok {
+			continue
+		}
+		if _, ok := seen[fqn]; ok {
+			continue
+		}
+		seen[fqn] = struct{}{}
+		pct := int(*v.UsedBytes * 100 / *v.CapacityBytes)
+		if pct <= threshold {
+			continue
+		}
+		hh = append(hh, AlertHit{
+			GVR:     client.PvcGVR,
+			Path:    fqn,
+			Message: fmt.Sprintf("Volume usage at %d%% (%s/%s)", pct, toMi(*v.UsedBytes), toMi(*v.CapacityBytes)),
+		})
+	}
+
+	return hh
+}
+
+// evalWarningEvents reports objects with more than threshold warning events within the window.
+func evalWarningEvents(ee []evv1.Event, reason string, threshold int, window time.Duration, now time.Time) ([]AlertHit, error) {
+	var rx *regexp.Regexp
+	if reason != "" {
+		var err error
+		if rx, err = regexp.Compile(reason); err != nil {
+			return nil, err
+		}
+	}
+
+	type hit struct {
+		AlertHit
+		count int
+		last  time.Time
+	}
+	hits := make(map[string]*hit)
+	keys := make([]string, 0, len(ee))
+	for i := range ee {
+		e := &ee[i]
+		if e.Type != v1.EventTypeWarning {
+			continue
+		}
+		if rx != nil && !rx.MatchString(e.Reason) {
+			continue
+		}
+		last := eventLastSeen(e)
+		if last.Before(now.Add(-window)) {
+			continue
+		}
+		path := client.FQN(e.Regarding.Namespace, e.Regarding.Name)
+		h, ok := hits[path]
+		if !ok {
+			h = &hit{AlertHit: AlertHit{GVR: alertKinds[e.Regarding.Kind], Path: path}}
+			hits[path] = h
+			keys = append(keys, path)
+		}
+		h.count += eventCount(e)
+		if !last.Before(h.last) {
+			h.last, h.Message = last, e.Reason+": "+e.Note
+		}
+	}
+
+	hh := make([]AlertHit, 0, len(keys))
+	for _, k := range keys {
+		if h := hits[k]; h.count > threshold {
+			hh = append(hh, h.AlertHit)
+		}
+	}
+
+	return hh, nil
+}
+
+func eventLastSeen(e *evv1.Event) time.Time {
+	switch {
+	case e.Series != nil && !e.Series.LastObservedTime.IsZero():
+		return e.Series.LastObservedTime.Time
+	case !e.DeprecatedLastTimestamp.IsZero():
+		return e.DeprecatedLastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	default:
+		return e.CreationTimestamp.Time
+	}
+}
+
+func eventCount(e *evv1.Event) int {
+	switch {
+	case e.Series != nil:
+		return int(e.Series.Count)
+	case e.DeprecatedCount > 0:
+		return int(e.DeprecatedCount)
+	default:
+		return 1
+	}
+}
+
+func toMi(b uint64) string {
+	return fmt.Sprintf("%dMi", b/(1024*1024))
+}
+
+func toTyped[T any](oo []runtime.Object) ([]T, error) {
+	tt := make([]T, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var t T
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &t); err != nil {
+			return nil, err
+		}
+		tt = append(tt, t)
+	}
+
+	return tt, nil
+}
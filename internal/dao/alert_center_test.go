@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	evv1 "k8s.io/api/events/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type alertSink []render.AlertRes
+
+func (s *alertSink) AlertFired(a render.AlertRes) {
+	*s = append(*s, a)
+}
+
+func makeRestartPod(n string, restarts int32) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "fred", Name: n},
+		Status: v1.PodStatus{
+			ContainerStatuses: []v1.ContainerStatus{{RestartCount: restarts}},
+		},
+	}
+}
+
+func TestEvalPodRestarts(t *testing.T) {
+	now := time.Now()
+	ss := make(map[string][]restartSample)
+
+	hh := evalPodRestarts(ss, []v1.Pod{makeRestartPod("p1", 10)}, 2, 5*time.Minute, now.Add(-10*time.Minute))
+	assert.Empty(t, hh)
+
+	hh = evalPodRestarts(ss, []v1.Pod{makeRestartPod("p1", 12)}, 2, 5*time.Minute, now.Add(-2*time.Minute))
+	assert.Empty(t, hh)
+
+	hh = evalPodRestarts(ss, []v1.Pod{makeRestartPod("p1", 13)}, 2, 5*time.Minute, now)
+	require.Len(t, hh, 1)
+	assert.Equal(t, AlertHit{GVR: client.PodGVR, Path: "fred/p1", Message: "3 restarts in the last 5m0s"}, hh[0])
+
+	hh = evalPodRestarts(ss, []v1.Pod{makeRestartPod("p2", 1)}, 2, 5*time.Minute, now)
+	assert.Empty(t, hh)
+	assert.Len(t, ss, 1)
+}
+
+func TestEvalNodesNotReady(t *testing.T) {
+	nn := []v1.Node{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "n1"},
+			Status:     v1.NodeStatus{Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionTrue}}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "n2"},
+			Status:     v1.NodeStatus{Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionUnknown, Message: "Kubelet stopped posting node status."}}},
+		},
+	}
+
+	hh := evalNodesNotReady(nn)
+	require.Len(t, hh, 1)
+	assert.Equal(t, AlertHit{GVR: client.NodeGVR, Path: "n2", Message: "Kubelet stopped posting node status."}, hh[0])
+}
+
+func TestEvalPVCUsage(t *testing.T) {
+	u := func(v uint64) *uint64 { return &v }
+	vol := func(n string, used, capa uint64) volumeStats {
+		return volumeStats{
+			Name:          n,
+			PVCRef:        &pvcRef{Name: n, Namespace: "fred"},
+			UsedBytes:     u(used),
+			CapacityBytes: u(capa),
+		}
+	}
+	vv := []volumeStats{
+		vol("c1", 90<<20, 100<<20),
+		vol("c2", 50<<20, 100<<20),
+		vol("c3", 99<<20, 100<<20),
+		{Name: "scratch", UsedBytes: u(1), CapacityBytes: u(1)},
+	}
+	pvcs := map[string]struct{}{"fred/c1": {}, "fred/c2": {}}
+
+	hh := evalPVCUsage(vv, pvcs, 0)
+	require.Len(t, hh, 1)
+	assert.Equal(t, AlertHit{GVR: client.PvcGVR, Path: "fred/c1", Message: "Volume usage at 90% (90Mi/100Mi)"}, hh[0])
+
+	assert.Len(t, evalPVCUsage(vv, pvcs, 40), 2)
+}
+
+func TestEvalWarningEvents(t *testing.T) {
+	now := time.Now()
+	ev := func(kind, n, typ, reason string, count int32, last time.Time) evv1.Event {
+		return evv1.Event{
+			Type:                    typ,
+			Reason:                  reason,
+			Note:                    "blee",
+			DeprecatedCount:         count,
+			DeprecatedLastTimestamp: metav1.NewTime(last),
+			Regarding:               v1.ObjectReference{Kind: kind, Namespace: "fred", Name: n},
+		}
+	}
+	ee := []evv1.Event{
+		ev("Pod", "p1", v1.EventTypeWarning, "BackOff", 3, now.Add(-time.Minute)),
+		ev("Pod", "p2", v1.EventTypeWarning, "BackOff", 1, now.Add(-time.Hour)),
+		ev("Pod", "p3", v1.EventTypeNormal, "Pulled", 1, now),
+		ev("Widget", "w1", v1.EventTypeWarning, "Failed", 1, now),
+	}
+
+	hh, err := evalWarningEvents(ee, "", 0, 10*time.Minute, now)
+	require.NoError(t, err)
+	require.Len(t, hh, 2)
+	assert.Equal(t, AlertHit{GVR: client.PodGVR, Path: "fred/p1", Message: "BackOff: blee"}, hh[0])
+	assert.Nil(t, hh[1].GVR)
+
+	hh, err = evalWarningEvents(ee, "Back", 2, 10*time.Minute, now)
+	require.NoError(t, err)
+	assert.Len(t, hh, 1)
+
+	_, err = evalWarningEvents(ee, "(", 0, time.Minute, now)
+	require.Error(t, err)
+}
+
+func TestAlertCenterUpdate(t *testing.T) {
+	var (
+		sink alertSink
+		r    = config.AlertRule{Name: "down", Kind: config.AlertNodeNotReady, Severity: config.AlertCritical}
+		hit  = AlertHit{GVR: client.NodeGVR, Path: "n1", Message: "not ready"}
+		now  = time.Now()
+	)
+	ac := NewAlertCenter(nil, []config.AlertRule{r})
+	ac.AddListener(&sink)
+
+	ac.Update(r, []AlertHit{hit}, now)
+	ac.Update(r, []AlertHit{hit}, now.Add(time.Minute))
+	require.Len(t, sink, 1)
+	aa := ac.Notifications()
+	require.Len(t, aa, 1)
+	assert.True(t, aa[0].Active)
+	assert.False(t, aa[0].Acked)
+
+	id := "down|n1"
+	ac.Ack(id)
+	assert.True(t, ac.Notifications()[0].Acked)
+
+	ac.Update(r, nil, now.Add(2*time.Minute))
+	aa = ac.Notifications()
+	require.Len(t, aa, 1)
+	assert.False(t, aa[0].Active)
+
+	ac.Update(r, []AlertHit{hit}, now.Add(3*time.Minute))
+	require.Len(t, sink, 2)
+	aa = ac.Notifications()
+	assert.Equal(t, 2, aa[0].Count)
+	assert.False(t, aa[0].Acked)
+	assert.Equal(t, now, aa[0].FirstSeen)
+
+	ac.Clear(id)
+	assert.Empty(t, ac.Notifications())
+}
@@ -6,12 +6,14 @@ package dao
 import (
 	"context"
 	"errors"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/perf"
 	"github.com/derailed/k9s/internal/render"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -31,6 +33,10 @@ type Benchmark struct {
 
 // Delete nukes a resource.
 func (*Benchmark) Delete(_ context.Context, path string, _ *metav1.DeletionPropagation, _ Grace) error {
+	if err := os.Remove(perf.ResultPath(path)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
 	return os.Remove(path)
 }
 
@@ -57,7 +63,7 @@ func (*Benchmark) List(ctx context.Context, _ string) ([]runtime.Object, error)
 	}
 	oo := make([]runtime.Object, 0, len(ff))
 	for _, f := range ff {
-		if !strings.HasPrefix(f.Name(), pathMatch) {
+		if !strings.HasPrefix(f.Name(), pathMatch) || perf.IsResultFile(f.Name()) {
 			continue
 		}
 		if fi, err := f.Info(); err == nil {
@@ -0,0 +1,288 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// LastAppliedAnnotation tracks the kubectl last applied configuration annotation.
+	LastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+	clientSideApplyManager = "kubectl-client-side-apply"
+	diffContext            = 3
+)
+
+// DiffObjects returns a unified diff between two resources ignoring volatile metadata.
+// An empty diff indicates both resources are identical.
+func DiffObjects(fromName string, from runtime.Object, toName string, to runtime.Object) (string, error) {
+	u1, err := toUnstructured(from)
+	if err != nil {
+		return "", err
+	}
+	u2, err := toUnstructured(to)
+	if err != nil {
+		return "", err
+	}
+
+	return unifiedDiff(fromName, sanitizeForDiff(u1.Object, true), toName, sanitizeForDiff(u2.Object, true))
+}
+
+// DiffLastApplied returns a unified diff between a resource intended state and its live state.
+// The intended state is read from the last-applied annotation or from apply managed fields.
+func DiffLastApplied(o runtime.Object) (string, error) {
+	u, err := toUnstructured(o)
+	if err != nil {
+		return "", err
+	}
+	live := sanitizeForDiff(u.Object, false)
+
+	if raw, ok := u.GetAnnotations()[LastAppliedAnnotation]; ok {
+		var applied map[string]any
+		if err := json.Unmarshal([]byte(raw), &applied); err != nil {
+			return "", fmt.Errorf("invalid last-applied configuration: %w", err)
+		}
+		return unifiedDiff("last-applied", applied, "live", projectOnto(live, applied))
+	}
+
+	fields, ok := appliedFields(u.GetManagedFields())
+	if !ok {
+		return "", errors.New("no last-applied configuration or apply managed fields found")
+	}
+
+	return unifiedDiff("applied (managedFields)", projectFields(live, fields), "live", live)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func toUnstructured(o runtime.Object) (*unstructured.Unstructured, error) {
+	switch t := o.(type) {
+	case nil:
+		return nil, errors.New("no object to diff")
+	case *unstructured.Unstructured:
+		return t, nil
+	default:
+		mm, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+		if err != nil {
+			return nil, err
+		}
+		return &unstructured.Unstructured{Object: mm}, nil
+	}
+}
+
+func unifiedDiff(fromName string, from any, toName string, to any) (string, error) {
+	a, err := yaml.Marshal(from)
+	if err != nil {
+		return "", err
+	}
+	b, err := yaml.Marshal(to)
+	if err != nil {
+		return "", err
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(strings.TrimSuffix(string(a), "\n")),
+		B:        difflib.SplitLines(strings.TrimSuffix(string(b), "\n")),
+		FromFile: fromName,
+		ToFile:   toName,
+		Context:  diffContext,
+	})
+}
+
+// sanitizeForDiff strips out server generated fields that would only add noise.
+func sanitizeForDiff(o map[string]any, keepStatus bool) map[string]any {
+	o = runtime.DeepCopyJSON(o)
+	for _, f := range []string{"uid", "resourceVersion", "creationTimestamp", "generation", "managedFields", "selfLink"} {
+		unstructured.RemoveNestedField(o, "metadata", f)
+	}
+	unstructured.RemoveNestedField(o, "metadata", "annotations", LastAppliedAnnotation)
+	if aa, ok, _ := unstructured.NestedMap(o, "metadata", "annotations"); ok && len(aa) == 0 {
+		unstructured.RemoveNestedField(o, "metadata", "annotations")
+	}
+	if !keepStatus {
+		delete(o, "status")
+	}
+
+	return o
+}
+
+// projectOnto retains live fields that are present in a given template.
+func projectOnto(live, tmpl any) any {
+	switch t := tmpl.(type) {
+	case map[string]any:
+		lm, ok := live.(map[string]any)
+		if !ok {
+			return live
+		}
+		res := make(map[string]any, len(t))
+		for k, v := range t {
+			if lv, ok := lm[k]; ok {
+				res[k] = projectOnto(lv, v)
+			}
+		}
+		return res
+	case []any:
+		ll, ok := live.([]any)
+		if !ok {
+			return live
+		}
+		res := make([]any, 0, len(ll))
+		for i, lv := range ll {
+			if tv, ok := templateItem(t, lv, i); ok {
+				res = append(res, projectOnto(lv, tv))
+				continue
+			}
+			res = append(res, lv)
+		}
+		return res
+	default:
+		return live
+	}
+}
+
+// templateItem locates a template list item by name or by position.
+func templateItem(tmpl []any, item any, idx int) (any, bool) {
+	if m, ok := item.(map[string]any); ok {
+		if n, ok := m["name"]; ok {
+			for _, t := range tmpl {
+				if tm, ok := t.(map[string]any); ok && tm["name"] == n {
+					return t, true
+				}
+			}
+			return nil, false
+		}
+	}
+	if idx < len(tmpl) {
+		return tmpl[idx], true
+	}
+
+	return nil, false
+}
+
+// appliedFields merges the fields owned by apply managers.
+func appliedFields(ff []metav1.ManagedFieldsEntry) (map[string]any, bool) {
+	var (
+		fields = make(map[string]any)
+		found  bool
+	)
+	for _, f := range ff {
+		if f.Operation != metav1.ManagedFieldsOperationApply && f.Manager != clientSideApplyManager {
+			continue
+		}
+		if f.FieldsV1 == nil || f.Subresource != "" {
+			continue
+		}
+		var mm map[string]any
+		if err := json.Unmarshal(f.FieldsV1.Raw, &mm); err != nil {
+			continue
+		}
+		mergeFields(fields, mm)
+		found = true
+	}
+
+	return fields, found
+}
+
+func mergeFields(dst, src map[string]any) {
+	for k, v := range src {
+		sv, _ := v.(map[string]any)
+		dv, ok := dst[k].(map[string]any)
+		if !ok {
+			dst[k] = sv
+			continue
+		}
+		mergeFields(dv, sv)
+	}
+}
+
+// projectFields retains live fields that are listed in a managed fields set.
+func projectFields(live any, set map[string]any) any {
+	if isLeafSet(set) {
+		return live
+	}
+	switch l := live.(type) {
+	case map[string]any:
+		res := make(map[string]any, len(set))
+		for k, v := range set {
+			n, ok := strings.CutPrefix(k, "f:")
+			if !ok {
+				continue
+			}
+			if lv, ok := l[n]; ok {
+				sub, _ := v.(map[string]any)
+				res[n] = projectFields(lv, sub)
+			}
+		}
+		return res
+	case []any:
+		res := make([]any, 0, len(l))
+		for i, item := range l {
+			for k, v := range set {
+				if matchesItem(k, item, i) {
+					sub, _ := v.(map[string]any)
+					res = append(res, projectFields(item, sub))
+					break
+				}
+			}
+		}
+		return res
+	default:
+		return live
+	}
+}
+
+func isLeafSet(set map[string]any) bool {
+	for k := range set {
+		if k != "." {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchesItem checks if a list item matches a managed fields key ie k:{"name":"fred"}, v:"blee" or i:0.
+func matchesItem(key string, item any, idx int) bool {
+	switch {
+	case strings.HasPrefix(key, "k:"):
+		var kk map[string]any
+		if err := json.Unmarshal([]byte(key[2:]), &kk); err != nil {
+			return false
+		}
+		m, ok := item.(map[string]any)
+		if !ok {
+			return false
+		}
+		for k, v := range kk {
+			if fmt.Sprintf("%v", m[k]) != fmt.Sprintf("%v", v) {
+				return false
+			}
+		}
+		return true
+	case strings.HasPrefix(key, "v:"):
+		var v any
+		if err := json.Unmarshal([]byte(key[2:]), &v); err != nil {
+			return false
+		}
+		return reflect.DeepEqual(v, item) || fmt.Sprintf("%v", v) == fmt.Sprintf("%v", item)
+	case strings.HasPrefix(key, "i:"):
+		i, err := strconv.Atoi(key[2:])
+		return err == nil && i == idx
+	default:
+		return false
+	}
+}
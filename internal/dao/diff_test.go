@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDiffObjects(t *testing.T) {
+	uu := map[string]struct {
+		o1, o2 map[string]any
+		e      string
+	}{
+		"same": {
+			o1: makeCM("cm1", "1", map[string]any{"a": "1"}),
+			o2: makeCM("cm1", "2", map[string]any{"a": "1"}),
+		},
+		"diff": {
+			o1: makeCM("cm1", "1", map[string]any{"a": "1", "b": "2"}),
+			o2: makeCM("cm2", "2", map[string]any{"a": "1", "b": "3"}),
+			e: `--- default/cm1
++++ default/cm2
+@@ -1,7 +1,7 @@
+ apiVersion: v1
+ data:
+   a: "1"
+-  b: "2"
++  b: "3"
+ kind: ConfigMap
+ metadata:
+-  name: cm1
++  name: cm2
+`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			d, err := DiffObjects(
+				"default/cm1", &unstructured.Unstructured{Object: u.o1},
+				"default/cm2", &unstructured.Unstructured{Object: u.o2},
+			)
+			require.NoError(t, err)
+			assert.Equal(t, u.e, d)
+		})
+	}
+}
+
+func TestDiffLastApplied(t *testing.T) {
+	uu := map[string]struct {
+		o   map[string]any
+		e   string
+		err string
+	}{
+		"no-source": {
+			o:   makeDP(nil, nil),
+			err: "no last-applied configuration or apply managed fields found",
+		},
+		"annotation-no-drift": {
+			o: makeDP(map[string]any{
+				LastAppliedAnnotation: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"fred"},"spec":{"replicas":1}}`,
+			}, nil),
+		},
+		"annotation-drift": {
+			o: makeDP(map[string]any{
+				LastAppliedAnnotation: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"fred"},"spec":{"replicas":3}}`,
+			}, nil),
+			e: `--- last-applied
++++ live
+@@ -3,4 +3,4 @@
+ metadata:
+   name: fred
+ spec:
+-  replicas: 3
++  replicas: 1
+`,
+		},
+		"managed-fields": {
+			o: makeDP(nil, []any{
+				map[string]any{
+					"manager":    "kubectl",
+					"operation":  "Apply",
+					"apiVersion": "apps/v1",
+					"fieldsType": "FieldsV1",
+					"fieldsV1": map[string]any{
+						"f:spec": map[string]any{"f:replicas": map[string]any{}},
+					},
+				},
+			}),
+			e: `--- applied (managedFields)
++++ live
+@@ -1,2 +1,8 @@
++apiVersion: apps/v1
++kind: Deployment
++metadata:
++  name: fred
++  namespace: default
+ spec:
+   replicas: 1
++  revisionHistoryLimit: 10
+`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			d, err := DiffLastApplied(&unstructured.Unstructured{Object: u.o})
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.e, d)
+		})
+	}
+}
+
+func TestProjectFields(t *testing.T) {
+	live := map[string]any{
+		"containers": []any{
+			map[string]any{"name": "c1", "image": "nginx", "imagePullPolicy": "Always"},
+			map[string]any{"name": "c2", "image": "busybox"},
+		},
+		"finalizers": []any{"a", "b"},
+	}
+	set := map[string]any{
+		"f:containers": map[string]any{
+			`k:{"name":"c1"}`: map[string]any{
+				".":       map[string]any{},
+				"f:image": map[string]any{},
+				"f:name":  map[string]any{},
+			},
+		},
+		"f:finalizers": map[string]any{
+			`v:"b"`: map[string]any{},
+		},
+	}
+
+	assert.Equal(t, map[string]any{
+		"containers": []any{
+			map[string]any{"name": "c1", "image": "nginx"},
+		},
+		"finalizers": []any{"b"},
+	}, projectFields(live, set))
+}
+
+// Helpers...
+
+func makeCM(n, rv string, data map[string]any) map[string]any {
+	return map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]any{
+			"name":            n,
+			"uid":             "uid-" + n + rv,
+			"resourceVersion": rv,
+		},
+		"data": data,
+	}
+}
+
+func makeDP(annotations map[string]any, managed []any) map[string]any {
+	m := map[string]any{
+		"name":      "fred",
+		"namespace": "default",
+	}
+	if annotations != nil {
+		m["annotations"] = annotations
+	}
+	if managed != nil {
+		m["managedFields"] = managed
+	}
+
+	return map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   m,
+		"spec": map[string]any{
+			"replicas":             int64(1),
+			"revisionHistoryLimit": int64(10),
+		},
+		"status": map[string]any{"replicas": int64(1)},
+	}
+}
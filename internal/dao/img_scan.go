@@ -6,11 +6,14 @@ package dao
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/vul"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -21,8 +24,9 @@ type ImageScan struct {
 	NonResource
 }
 
-func (is *ImageScan) listImages(ctx context.Context, gvr *client.GVR, path string) ([]string, error) {
-	res, err := AccessorFor(is.Factory, gvr)
+// ListImages lists the container images for a given resource.
+func ListImages(ctx context.Context, f Factory, gvr *client.GVR, path string) ([]string, error) {
+	res, err := AccessorFor(f, gvr)
 	if err != nil {
 		return nil, err
 	}
@@ -34,6 +38,26 @@ func (is *ImageScan) listImages(ctx context.Context, gvr *client.GVR, path strin
 	return s.ListImages(ctx, path)
 }
 
+// NamespaceImages lists the unique container images of all pods in a given
+// namespace. Use client.BlankNamespace for the whole cluster.
+func NamespaceImages(f Factory, ns string) ([]string, error) {
+	oo, err := f.List(client.PodGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pp, err := toTyped[v1.Pod](oo)
+	if err != nil {
+		return nil, err
+	}
+	ii := make([]string, 0, len(pp))
+	for i := range pp {
+		ii = append(ii, render.ExtractImages(&pp[i].Spec)...)
+	}
+	slices.Sort(ii)
+
+	return slices.Compact(ii), nil
+}
+
 // List returns a collection of scans.
 func (is *ImageScan) List(ctx context.Context, _ string) ([]runtime.Object, error) {
 	fqn, ok := ctx.Value(internal.KeyPath).(string)
@@ -45,7 +69,7 @@ func (is *ImageScan) List(ctx context.Context, _ string) ([]runtime.Object, erro
 		return nil, fmt.Errorf("no context gvr for %q", is.gvr)
 	}
 
-	ii, err := is.listImages(ctx, gvr, fqn)
+	ii, err := ListImages(ctx, is.Factory, gvr, fqn)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestNamespaceImages(t *testing.T) {
+	p1, p2 := load("p1"), load("p1")
+	p2.SetName("p2")
+	require.NoError(t, unstructured.SetNestedSlice(p2.Object, []any{
+		map[string]any{"name": "c1", "image": "redis:7.2"},
+		map[string]any{"name": "c2", "image": "k8s.gcr.io/nginx-slim:0.8"},
+	}, "spec", "containers"))
+	f := &testFactory{
+		inventory: map[string]map[*client.GVR][]runtime.Object{
+			"default": {
+				client.PodGVR: {p1, p2},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		ns string
+		e  []string
+	}{
+		"default": {
+			ns: "default",
+			e:  []string{"k8s.gcr.io/nginx-slim:0.8", "redis:7.2"},
+		},
+		"empty": {
+			ns: "fred",
+			e:  []string{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ii, err := dao.NamespaceImages(f, u.ns)
+			require.NoError(t, err)
+			assert.Equal(t, u.e, ii)
+		})
+	}
+}
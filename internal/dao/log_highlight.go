@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/derailed/k9s/internal/config"
+)
+
+const highlightReset = "[-:-:-]"
+
+type highlightRule struct {
+	rx   *regexp.Regexp
+	tag  string
+	line bool
+}
+
+type highlightSpan struct {
+	start, end int
+	tag        string
+}
+
+// LogHighlighter styles log lines matching a collection of regex rules.
+type LogHighlighter struct {
+	rules []highlightRule
+}
+
+// NewLogHighlighter returns a new highlighter. Invalid rules are skipped and reported.
+func NewLogHighlighter(rr []config.LogHighlight) (*LogHighlighter, error) {
+	var (
+		h    LogHighlighter
+		errs error
+	)
+	for _, r := range rr {
+		rx, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("invalid log highlight pattern %q: %w", r.Pattern, err))
+			continue
+		}
+		h.rules = append(h.rules, highlightRule{
+			rx:   rx,
+			tag:  "[" + r.Style + "]",
+			line: r.Line,
+		})
+	}
+
+	return &h, errs
+}
+
+// IsEmpty returns true if no rules are defined.
+func (h *LogHighlighter) IsEmpty() bool {
+	return h == nil || len(h.rules) == 0
+}
+
+// Highlight returns a styled version of a log line. The first matching line rule
+// styles the whole line. Other rules style their matches, first rule wins on overlaps.
+func (h *LogHighlighter) Highlight(line []byte) []byte {
+	if h.IsEmpty() {
+		return line
+	}
+	text, eol := bytes.CutSuffix(line, []byte{'\n'})
+
+	var (
+		lineTag string
+		spans   []highlightSpan
+	)
+	for _, r := range h.rules {
+		if r.line {
+			if lineTag == "" && r.rx.Match(text) {
+				lineTag = r.tag
+			}
+			continue
+		}
+		for _, loc := range r.rx.FindAllIndex(text, -1) {
+			if loc[0] == loc[1] || overlaps(spans, loc[0], loc[1]) {
+				continue
+			}
+			spans = append(spans, highlightSpan{start: loc[0], end: loc[1], tag: r.tag})
+		}
+	}
+	if lineTag == "" && len(spans) == 0 {
+		return line
+	}
+	sort.Slice(spans, func(i, j int) bool {
+		return spans[i].start < spans[j].start
+	})
+
+	reset := highlightReset
+	if lineTag != "" {
+		reset = lineTag
+	}
+	bb := bytes.NewBuffer(make([]byte, 0, len(line)+len(spans)*20+len(lineTag)+len(highlightReset)))
+	bb.WriteString(lineTag)
+	var prev int
+	for _, s := range spans {
+		bb.Write(text[prev:s.start])
+		bb.WriteString(s.tag)
+		bb.Write(text[s.start:s.end])
+		bb.WriteString(reset)
+		prev = s.end
+	}
+	bb.Write(text[prev:])
+	if lineTag != "" {
+		bb.WriteString(highlightReset)
+	}
+	if eol {
+		bb.WriteByte('\n')
+	}
+
+	return bb.Bytes()
+}
+
+func overlaps(spans []highlightSpan, start, end int) bool {
+	for _, s := range spans {
+		if start < s.end && s.start < end {
+			return true
+		}
+	}
+
+	return false
+}
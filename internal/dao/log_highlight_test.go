@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogHighlighter(t *testing.T) {
+	uu := map[string]struct {
+		rules []config.LogHighlight
+		l, e  string
+	}{
+		"none": {
+			l: "GET /fred 500\n",
+			e: "GET /fred 500\n",
+		},
+		"no-match": {
+			rules: presetRules(t, "levels"),
+			l:     "all good\n",
+			e:     "all good\n",
+		},
+		"word": {
+			rules: presetRules(t, "levels"),
+			l:     "ERROR boom\n",
+			e:     "[red::b]ERROR[-:-:-] boom\n",
+		},
+		"multi": {
+			rules: presetRules(t, "levels", "http-status"),
+			l:     "WARN GET /fred 404",
+			e:     "[orange::b]WARN[-:-:-] GET /fred [orange]404[-:-:-]",
+		},
+		"overlap": {
+			rules: []config.LogHighlight{
+				{Pattern: `time out`, Style: "red"},
+				{Pattern: `out`, Style: "blue"},
+			},
+			l: "time out, out\n",
+			e: "[red]time out[-:-:-], [blue]out[-:-:-]\n",
+		},
+		"line": {
+			rules: []config.LogHighlight{
+				{Pattern: `^\s+at `, Style: "orange", Line: true},
+				{Pattern: `Fred\.java`, Style: "blue"},
+			},
+			l: "  at com.Fred(Fred.java:10)\n",
+			e: "[orange]  at com.Fred([blue]Fred.java[orange]:10)[-:-:-]\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			h, err := dao.NewLogHighlighter(u.rules)
+			require.NoError(t, err)
+			assert.Equal(t, u.e, string(h.Highlight([]byte(u.l))))
+		})
+	}
+}
+
+func TestLogHighlighterInvalid(t *testing.T) {
+	h, err := dao.NewLogHighlighter([]config.LogHighlight{
+		{Pattern: `(oops`, Style: "red"},
+		{Pattern: `fred`, Style: "blue"},
+	})
+	require.Error(t, err)
+	assert.False(t, h.IsEmpty())
+	assert.Equal(t, "[blue]fred[-:-:-]", string(h.Highlight([]byte("fred"))))
+}
+
+func TestLogItemsHighlight(t *testing.T) {
+	ii := dao.NewLogItems()
+	ii.Add(dao.NewLogItemFromString("2025-01-01T10:00:00Z ERROR boom\n"))
+	h, err := dao.NewLogHighlighter(presetRules(t, "levels"))
+	require.NoError(t, err)
+	ii.SetHighlighter(h)
+
+	assert.Equal(t, []string{"[red::b]ERROR[-:-:-] boom\n"}, ii.StrLines(0, false))
+}
+
+func TestLogItemsHighlightStructured(t *testing.T) {
+	ii := dao.NewLogItems()
+	ii.Add(dao.NewLogItemFromString(`2025-01-01T10:00:00Z {"level":"info","msg":"GET /fred 500","code":"404"}` + "\n"))
+	ii.SetStructured(true)
+	h, err := dao.NewLogHighlighter(presetRules(t, "http-status"))
+	require.NoError(t, err)
+	ii.SetHighlighter(h)
+
+	ll := ii.StrLines(0, false)
+	require.Len(t, ll, 1)
+	assert.Contains(t, ll[0], "GET /fred [red::b]500[-:-:-]")
+	assert.Contains(t, ll[0], "code=[-::][orange]404[-:-:-]")
+}
+
+// Helpers...
+
+func presetRules(t *testing.T, pp ...string) []config.LogHighlight {
+	var hh config.LogHighlights
+	hh.Highlights.Presets = pp
+	rr, err := hh.Rules(config.NewStyles().Views().Log.Highlight)
+	require.NoError(t, err)
+
+	return rr
+}
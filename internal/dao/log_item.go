@@ -5,8 +5,19 @@ package dao
 
 import (
 	"bytes"
+	"fmt"
+	"regexp"
+	"sync/atomic"
 )
 
+const levelWidth = 5
+
+// noRecord tracks log items that are not structured.
+var noRecord = new(LogRecord)
+
+// escapedRX matches tags escaped by tview ie [x[] for [x].
+var escapedRX = regexp.MustCompile(`\[([a-zA-Z0-9_,;: \-\."#]+)\[(\[*)\]`)
+
 // LogChan represents a channel for logs.
 type LogChan chan *LogItem
 
@@ -18,6 +29,8 @@ type LogItem struct {
 	SingleContainer bool
 	Bytes           []byte
 	IsError         bool
+
+	record atomic.Pointer[LogRecord]
 }
 
 // NewLogItem returns a new item.
@@ -61,24 +74,108 @@ func (l *LogItem) IsEmpty() bool {
 	return len(l.Bytes) == 0
 }
 
+// Raw returns the log line as emitted by the container ie without tview escapes.
+func (l *LogItem) Raw() []byte {
+	return escapedRX.ReplaceAll(l.Bytes, []byte(`[$1$2]`))
+}
+
 // Size returns the size of the item.
 func (l *LogItem) Size() int {
 	return 100 + len(l.Bytes) + len(l.Pod) + len(l.Container)
 }
 
+// Record returns the structured log record if the line is either JSON or logfmt.
+func (l *LogItem) Record() (*LogRecord, bool) {
+	if r := l.record.Load(); r != nil {
+		return r, r != noRecord
+	}
+	r, ok := ParseLogRecord(l.content())
+	if !ok {
+		r = noRecord
+	}
+	l.record.Store(r)
+
+	return r, ok
+}
+
+func (l *LogItem) content() []byte {
+	if index := bytes.Index(l.Bytes, []byte{' '}); index > 0 {
+		return l.Bytes[index+1:]
+	}
+
+	return l.Bytes
+}
+
 // Render returns a log line as string.
 func (l *LogItem) Render(paint string, showTime bool, bb *bytes.Buffer) {
 	index := bytes.Index(l.Bytes, []byte{' '})
 	if showTime && index > 0 {
-		bb.WriteString("[gray::b]")
-		bb.Write(l.Bytes[:index])
-		bb.WriteString(" ")
-		if l := 30 - len(l.Bytes[:index]); l > 0 {
-			bb.Write(bytes.Repeat([]byte{' '}, l))
+		renderTime(l.Bytes[:index], bb)
+	}
+	l.renderSource(paint, bb)
+
+	if index > 0 {
+		bb.Write(l.Bytes[index+1:])
+	} else {
+		bb.Write(l.Bytes)
+	}
+}
+
+// RenderStructured renders a structured log line as aligned time, level and message columns.
+// Message and field values are styled using the given highlighter.
+// Returns false and renders nothing if the line is not structured.
+func (l *LogItem) RenderStructured(paint string, showTime bool, hl *LogHighlighter, bb *bytes.Buffer) bool {
+	r, ok := l.Record()
+	if !ok {
+		return false
+	}
+	if showTime {
+		ts := r.Time
+		if ts == "" {
+			ts = l.GetTimestamp()
 		}
-		bb.WriteString("[-::-]")
+		renderTime([]byte(ts), bb)
+	}
+	l.renderSource(paint, bb)
+
+	fmt.Fprintf(bb, "[%s::b]%-*s[-::-] %s", LevelColor(r.Level), levelWidth, r.Level, hl.Highlight([]byte(r.Message)))
+	for _, k := range r.Keys {
+		fmt.Fprintf(bb, " [gray::]%s=[-::]%s", k, hl.Highlight([]byte(r.Fields[k])))
+	}
+	bb.WriteString("\n")
+
+	return true
+}
+
+// LevelColor returns the color associated with a given log level.
+func LevelColor(level string) string {
+	switch NormalizeLevel(level) {
+	case "ERROR", "FATAL", "PANIC", "CRIT", "ALERT", "EMERG":
+		return "red"
+	case "WARN":
+		return "orange"
+	case "INFO":
+		return "green"
+	case "DEBUG":
+		return "aqua"
+	case "TRACE":
+		return "gray"
+	default:
+		return "white"
 	}
+}
 
+func renderTime(ts []byte, bb *bytes.Buffer) {
+	bb.WriteString("[gray::b]")
+	bb.Write(ts)
+	bb.WriteString(" ")
+	if l := 30 - len(ts); l > 0 {
+		bb.Write(bytes.Repeat([]byte{' '}, l))
+	}
+	bb.WriteString("[-::-]")
+}
+
+func (l *LogItem) renderSource(paint string, bb *bytes.Buffer) {
 	if l.Pod != "" {
 		bb.WriteString("[" + paint + "::]" + l.Pod)
 	}
@@ -91,10 +188,4 @@ func (l *LogItem) Render(paint string, showTime bool, bb *bytes.Buffer) {
 	} else if l.Pod != "" {
 		bb.WriteString("[-::] ")
 	}
-
-	if index > 0 {
-		bb.Write(l.Bytes[index+1:])
-	} else {
-		bb.Write(l.Bytes)
-	}
 }
@@ -32,6 +32,24 @@ func TestLogItemEmpty(t *testing.T) {
 	}
 }
 
+func TestLogItemRaw(t *testing.T) {
+	uu := map[string]string{
+		"plain":   "Testing 1,2,3...",
+		"tag":     "[x] blee",
+		"level":   "2018-12-14T10:36:43.326972-07:00 [INFO] fred [zorg:1]",
+		"escaped": "[x[] [[y]]",
+		"json":    `{"a":["b","c"]}`,
+	}
+
+	for k := range uu {
+		s := uu[k]
+		t.Run(k, func(t *testing.T) {
+			i := dao.NewLogItem(tview.EscapeBytes([]byte(s)))
+			assert.Equal(t, s, string(i.Raw()))
+		})
+	}
+}
+
 func TestLogItemRender(t *testing.T) {
 	uu := map[string]struct {
 		opts dao.LogOptions
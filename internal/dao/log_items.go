@@ -29,9 +29,11 @@ var podPalette = []string{
 
 // LogItems represents a collection of log items.
 type LogItems struct {
-	items     []*LogItem
-	podColors podColors
-	mx        sync.RWMutex
+	items       []*LogItem
+	podColors   podColors
+	structured  bool
+	highlighter *LogHighlighter
+	mx          sync.RWMutex
 }
 
 // NewLogItems returns a new instance.
@@ -49,6 +51,30 @@ func (l *LogItems) Items() []*LogItem {
 	return l.items
 }
 
+// SetStructured toggles structured logs rendering.
+func (l *LogItems) SetStructured(b bool) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	l.structured = b
+}
+
+// SetHighlighter sets the log highlight rules.
+func (l *LogItems) SetHighlighter(h *LogHighlighter) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	l.highlighter = h
+}
+
+// IsStructured returns true if structured logs rendering is on.
+func (l *LogItems) IsStructured() bool {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	return l.structured
+}
+
 // Len returns the items length.
 func (l *LogItems) Len() int {
 	l.mx.RLock()
@@ -82,8 +108,9 @@ func (l *LogItems) Subset(index int) *LogItems {
 	defer l.mx.RUnlock()
 
 	return &LogItems{
-		items:     l.items[index:],
-		podColors: l.podColors,
+		items:      l.items[index:],
+		podColors:  l.podColors,
+		structured: l.structured,
 	}
 }
 
@@ -120,6 +147,20 @@ func (l *LogItems) podColorFor(id string) string {
 	return l.podColors[id]
 }
 
+func (l *LogItems) render(item *LogItem, bb *bytes.Buffer, showTime bool) {
+	if l.structured && item.RenderStructured(l.podColorFor(item.ID()), showTime, l.highlighter, bb) {
+		return
+	}
+	item.Render(l.podColorFor(item.ID()), showTime, bb)
+	if l.highlighter.IsEmpty() {
+		return
+	}
+	start := bb.Len() - len(item.content())
+	hl := l.highlighter.Highlight(bb.Bytes()[start:])
+	bb.Truncate(start)
+	bb.Write(hl)
+}
+
 // Lines returns a collection of log lines.
 func (l *LogItems) Lines(index int, showTime bool, ll [][]byte) {
 	l.mx.Lock()
@@ -127,7 +168,7 @@ func (l *LogItems) Lines(index int, showTime bool, ll [][]byte) {
 
 	for i, item := range l.items[index:] {
 		bb := bytes.NewBuffer(make([]byte, 0, item.Size()))
-		item.Render(l.podColorFor(item.ID()), showTime, bb)
+		l.render(item, bb, showTime)
 		ll[i] = bb.Bytes()
 	}
 }
@@ -140,7 +181,7 @@ func (l *LogItems) StrLines(index int, showTime bool) []string {
 	ll := make([]string, len(l.items[index:]))
 	for i, item := range l.items[index:] {
 		bb := bytes.NewBuffer(make([]byte, 0, item.Size()))
-		item.Render(l.podColorFor(item.ID()), showTime, bb)
+		l.render(item, bb, showTime)
 		ll[i] = bb.String()
 	}
 
@@ -151,7 +192,7 @@ func (l *LogItems) StrLines(index int, showTime bool) []string {
 func (l *LogItems) Render(index int, showTime bool, ll [][]byte) {
 	for i, item := range l.items[index:] {
 		bb := bytes.NewBuffer(make([]byte, 0, item.Size()))
-		item.Render(l.podColorFor(item.ID()), showTime, bb)
+		l.render(item, bb, showTime)
 		ll[i] = bb.Bytes()
 	}
 }
@@ -173,6 +214,11 @@ func (l *LogItems) Filter(index int, q string, showTime bool) (matches []int, in
 		matches, indices = l.fuzzyFilter(index, f, showTime)
 		return
 	}
+	if l.IsStructured() {
+		if matches, indices, ok := l.fieldFilter(index, q); ok {
+			return matches, indices, nil
+		}
+	}
 	matches, indices, err = l.filterLogs(index, q, showTime)
 	if err != nil {
 		return
@@ -193,6 +239,36 @@ func (l *LogItems) fuzzyFilter(index int, q string, showTime bool) (matches []in
 	return matches, indices
 }
 
+// fieldFilter filters structured log items using field predicates ie level=error latency>500ms.
+func (l *LogItems) fieldFilter(index int, q string) (matches []int, indices [][]int, ok bool) {
+	var invert bool
+	if internal.IsInverseSelector(q) {
+		invert = true
+		q = q[1:]
+	}
+	pp, ok := ParseLogPredicates(q)
+	if !ok {
+		return nil, nil, false
+	}
+
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+	matches, indices = make([]int, 0, len(l.items)), make([][]int, 0, len(l.items))
+	for i, item := range l.items[index:] {
+		r, ok := item.Record()
+		if !ok {
+			continue
+		}
+		if MatchAll(r, pp) == invert {
+			continue
+		}
+		matches = append(matches, i)
+		indices = append(indices, nil)
+	}
+
+	return matches, indices, true
+}
+
 func (l *LogItems) filterLogs(index int, q string, showTime bool) (matches []int, indices [][]int, err error) {
 	var invert bool
 	if internal.IsInverseSelector(q) {
@@ -143,3 +143,42 @@ func TestLogItemsRender(t *testing.T) {
 		})
 	}
 }
+
+func TestLogItemsStructured(t *testing.T) {
+	ts := "2018-12-14T10:36:43.326972-07:00"
+	ii := dao.NewLogItems()
+	ii.SetStructured(true)
+	ii.Add(
+		dao.NewLogItemFromString(ts+` {"level":"info","msg":"started","port":8080}`+"\n"),
+		dao.NewLogItemFromString(ts+` level=error msg="request failed" latency=750ms`+"\n"),
+		dao.NewLogItemFromString(ts+" Testing 1,2,3...\n"),
+		dao.NewLogItemFromString(ts+` {"level":"warn","msg":"slow","latency":"300ms"}`+"\n"),
+	)
+
+	res := make([][]byte, ii.Len())
+	ii.Render(0, false, res)
+	assert.Equal(t, "[green::b]INFO [-::-] started [gray::]port=[-::]8080\n", string(res[0]))
+	assert.Equal(t, "[red::b]ERROR[-::-] request failed [gray::]latency=[-::]750ms\n", string(res[1]))
+	assert.Equal(t, "Testing 1,2,3...\n", string(res[2]))
+
+	uu := map[string]struct {
+		q string
+		e []int
+	}{
+		"level":    {q: "level=error", e: []int{1}},
+		"latency":  {q: "latency>500ms", e: []int{1}},
+		"inverse":  {q: "!level=error", e: []int{0, 3}},
+		"combined": {q: "latency<1s level=warn", e: []int{3}},
+		"regex":    {q: "Testing", e: []int{2}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			matches, indices, err := ii.Filter(0, u.q, false)
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, matches)
+			assert.Len(t, indices, len(matches))
+		})
+	}
+}
@@ -4,7 +4,9 @@
 package dao
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
@@ -19,6 +21,8 @@ type LogOptions struct {
 	Container        string
 	DefaultContainer string
 	SinceTime        string
+	StartTime        time.Time
+	EndTime          time.Time
 	Lines            int64
 	SinceSeconds     int64
 	Head             bool
@@ -52,12 +56,47 @@ func (o *LogOptions) Clone() *LogOptions {
 		MultiPods:        o.MultiPods,
 		ShowTimestamp:    o.ShowTimestamp,
 		SinceTime:        o.SinceTime,
+		StartTime:        o.StartTime,
+		EndTime:          o.EndTime,
 		SinceSeconds:     o.SinceSeconds,
 		AllContainers:    o.AllContainers,
 		LogBufferSize:    o.LogBufferSize,
 	}
 }
 
+// HasRange checks if logs are constrained to a time range.
+func (o *LogOptions) HasRange() bool {
+	return !o.StartTime.IsZero()
+}
+
+// SetRange constrains logs to a time range. A zero end time follows the logs.
+func (o *LogOptions) SetRange(start, end time.Time) {
+	o.StartTime, o.EndTime = start, end
+	o.SinceSeconds, o.Head = 0, false
+}
+
+// ClearRange resets the logs time range.
+func (o *LogOptions) ClearRange() {
+	o.StartTime, o.EndTime = time.Time{}, time.Time{}
+}
+
+// IsPastRange checks if a given log line is past the range end time.
+func (o *LogOptions) IsPastRange(bb []byte) bool {
+	if o.EndTime.IsZero() {
+		return false
+	}
+	ts, _, ok := strings.Cut(string(bb), " ")
+	if !ok {
+		return false
+	}
+	t, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return false
+	}
+
+	return t.After(o.EndTime)
+}
+
 // HasContainer checks if a container is present.
 func (o *LogOptions) HasContainer() bool {
 	return o.Container != ""
@@ -95,6 +134,12 @@ func (o *LogOptions) ToPodLogOptions() *v1.PodLogOptions {
 		opts.LimitBytes = &maxBytes
 		return &opts
 	}
+	if o.HasRange() {
+		opts.TailLines, opts.SinceSeconds = nil, nil
+		opts.SinceTime = &metav1.Time{Time: o.StartTime}
+		opts.Follow = o.EndTime.IsZero() || time.Now().Before(o.EndTime)
+		return &opts
+	}
 	if o.SinceSeconds < 0 {
 		return &opts
 	}
@@ -134,6 +179,52 @@ func (o *LogOptions) ToLogItem(bytes []byte) *LogItem {
 	return item
 }
 
+// ParseLogTime parses a log range time either as RFC3339, date and time or time of day in local time.
+func ParseLogTime(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	for _, layout := range []string{"2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02T15:04"} {
+		if t, err := time.ParseInLocation(layout, s, now.Location()); err == nil {
+			return t, nil
+		}
+	}
+	for _, layout := range []string{"15:04:05", "15:04"} {
+		t, err := time.ParseInLocation(layout, s, now.Location())
+		if err != nil {
+			continue
+		}
+		y, m, d := now.Date()
+		return time.Date(y, m, d, t.Hour(), t.Minute(), t.Second(), 0, now.Location()), nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %q. Use HH:MM[:SS], YYYY-MM-DD HH:MM[:SS] or RFC3339", s)
+}
+
+// ParseLogRange parses a log time range. An empty end time follows the logs.
+func ParseLogRange(start, end string, now time.Time) (time.Time, time.Time, error) {
+	t1, err := ParseLogTime(start, now)
+	if err != nil {
+		return t1, t1, err
+	}
+	if t1.IsZero() {
+		return t1, t1, errors.New("a range start time is required")
+	}
+	t2, err := ParseLogTime(end, now)
+	if err != nil {
+		return t1, t2, err
+	}
+	if !t2.IsZero() && !t2.After(t1) {
+		return t1, t2, errors.New("range end time must be after its start time")
+	}
+
+	return t1, t2, nil
+}
+
 func (*LogOptions) ToErrLogItem(err error) *LogItem {
 	t := time.Now().UTC().Format(time.RFC3339Nano)
 	item := NewLogItem([]byte(fmt.Sprintf("%s [orange::b]%s[::-]\n", t, err)))
@@ -5,6 +5,7 @@ package dao_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/stretchr/testify/assert"
@@ -44,3 +45,79 @@ func TestLogOptionsToggleAllContainers(t *testing.T) {
 		})
 	}
 }
+
+func TestLogOptionsRange(t *testing.T) {
+	start := time.Date(2025, 1, 1, 2, 10, 0, 0, time.UTC)
+	o := dao.LogOptions{Lines: 100, SinceSeconds: 300, Head: true}
+	o.SetRange(start, start.Add(15*time.Minute))
+
+	assert.True(t, o.HasRange())
+	assert.False(t, o.Head)
+	po := o.ToPodLogOptions()
+	assert.Nil(t, po.TailLines)
+	assert.Nil(t, po.SinceSeconds)
+	assert.Equal(t, start, po.SinceTime.Time)
+	assert.False(t, po.Follow)
+
+	assert.False(t, o.IsPastRange([]byte("2025-01-01T02:24:59.123Z blee")))
+	assert.True(t, o.IsPastRange([]byte("2025-01-01T02:25:00.001Z blee")))
+	assert.False(t, o.IsPastRange([]byte("blee")))
+
+	o.SetRange(start, time.Time{})
+	assert.True(t, o.ToPodLogOptions().Follow)
+	assert.False(t, o.IsPastRange([]byte("2030-01-01T00:00:00Z blee")))
+
+	o.ClearRange()
+	assert.False(t, o.HasRange())
+}
+
+func TestParseLogRange(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	uu := map[string]struct {
+		start, end string
+		t1, t2     time.Time
+		err        string
+	}{
+		"time-of-day": {
+			start: "02:10",
+			end:   "02:25:30",
+			t1:    time.Date(2025, 1, 1, 2, 10, 0, 0, time.UTC),
+			t2:    time.Date(2025, 1, 1, 2, 25, 30, 0, time.UTC),
+		},
+		"date-time": {
+			start: "2024-12-31 23:50",
+			t1:    time.Date(2024, 12, 31, 23, 50, 0, 0, time.UTC),
+		},
+		"rfc3339": {
+			start: "2024-12-31T23:50:00-07:00",
+			t1:    time.Date(2024, 12, 31, 23, 50, 0, 0, time.FixedZone("", -7*60*60)),
+		},
+		"no-start": {
+			end: "02:25",
+			err: "a range start time is required",
+		},
+		"invalid": {
+			start: "blee",
+			err:   `invalid time "blee". Use HH:MM[:SS], YYYY-MM-DD HH:MM[:SS] or RFC3339`,
+		},
+		"reversed": {
+			start: "02:25",
+			end:   "02:10",
+			err:   "range end time must be after its start time",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			t1, t2, err := dao.ParseLogRange(u.start, u.end, now)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, u.t1.Equal(t1), t1)
+			assert.True(t, u.t2.Equal(t2), t2)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*LogPattern)(nil)
+
+var logMasks = []struct {
+	rx   *regexp.Regexp
+	mask string
+	keep func(string) bool
+}{
+	{rx: regexp.MustCompile(`(?i)\b[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`), mask: "<uuid>"},
+	{rx: regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}(?::\d+)?\b`), mask: "<ip>"},
+	{rx: regexp.MustCompile(`(?i)\b(?:[0-9a-f]{1,4}:){7}[0-9a-f]{1,4}\b|\b(?:[0-9a-f]{1,4}:)+:(?:[0-9a-f]{1,4}:)*[0-9a-f]{1,4}\b`), mask: "<ip>"},
+	{rx: regexp.MustCompile(`(?i)\b0x[0-9a-f]+\b`), mask: "<hex>"},
+	{rx: regexp.MustCompile(`(?i)\b[0-9a-f]{12,}\b`), mask: "<hex>", keep: isDecimal},
+	{rx: regexp.MustCompile(`\d+(?:\.\d+)*`), mask: "<num>"},
+}
+
+// MaskLogLine replaces variable tokens such as uuids, ips and numbers by placeholders.
+func MaskLogLine(s string) string {
+	for _, m := range logMasks {
+		if m.keep == nil {
+			s = m.rx.ReplaceAllString(s, m.mask)
+			continue
+		}
+		s = m.rx.ReplaceAllStringFunc(s, func(tok string) string {
+			if m.keep(tok) {
+				return tok
+			}
+			return m.mask
+		})
+	}
+
+	return s
+}
+
+func isDecimal(s string) bool {
+	return strings.IndexFunc(s, func(r rune) bool { return r < '0' || r > '9' }) == -1
+}
+
+// Patterns clusters log lines by their masked content, most frequent first.
+func (l *LogItems) Patterns() []render.LogPatternRes {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	index := make(map[string]int)
+	pp := make([]render.LogPatternRes, 0, 10)
+	for _, item := range l.items {
+		if item.IsEmpty() {
+			continue
+		}
+		line := strings.TrimSpace(string(item.content()))
+		if line == "" {
+			continue
+		}
+		t, _ := time.Parse(time.RFC3339Nano, item.GetTimestamp())
+		tpl := MaskLogLine(line)
+		i, ok := index[tpl]
+		if !ok {
+			index[tpl] = len(pp)
+			pp = append(pp, render.LogPatternRes{
+				Template:  tpl,
+				FirstSeen: t,
+			})
+			i = len(pp) - 1
+		}
+		p := &pp[i]
+		p.Count++
+		if p.FirstSeen.IsZero() || (!t.IsZero() && t.Before(p.FirstSeen)) {
+			p.FirstSeen = t
+		}
+		if t.After(p.LastSeen) {
+			p.LastSeen = t
+		}
+		p.Lines = append(p.Lines, strings.TrimRight(string(item.Bytes), "\r\n"))
+	}
+	slices.SortStableFunc(pp, func(a, b render.LogPatternRes) int {
+		return cmp.Compare(b.Count, a.Count)
+	})
+
+	return pp
+}
+
+// LogPattern tracks log line clusters.
+type LogPattern struct {
+	NonResource
+}
+
+// NewLogPattern returns a new log patterns accessor.
+func NewLogPattern(f Factory) *LogPattern {
+	var p LogPattern
+	p.Init(f, client.LpGVR)
+
+	return &p
+}
+
+// List returns a collection of log patterns.
+func (*LogPattern) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	lines, ok := ctx.Value(internal.KeyLogItems).(*LogItems)
+	if !ok {
+		return nil, errors.New("no log items in context")
+	}
+
+	pp := lines.Patterns()
+	oo := make([]runtime.Object, 0, len(pp))
+	for _, p := range pp {
+		oo = append(oo, p)
+	}
+
+	return oo, nil
+}
+
+// Get fetch a resource.
+func (*LogPattern) Get(_ context.Context, _ string) (runtime.Object, error) {
+	return nil, errors.New("nyi")
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaskLogLine(t *testing.T) {
+	uu := map[string]struct {
+		l, e string
+	}{
+		"plain": {
+			l: "server started",
+			e: "server started",
+		},
+		"numbers": {
+			l: "took 512ms for 3 items, ratio 0.75",
+			e: "took <num>ms for <num> items, ratio <num>",
+		},
+		"uuid": {
+			l: "request 0f8fad5b-d9cb-469f-a165-70867728950e done",
+			e: "request <uuid> done",
+		},
+		"ipv4": {
+			l: "connect to 10.0.0.12:8080 from 192.168.1.1",
+			e: "connect to <ip> from <ip>",
+		},
+		"ipv6": {
+			l: "listening on fe80::1ff:fe23:4567:890a",
+			e: "listening on <ip>",
+		},
+		"hex": {
+			l: "commit 3f2a9c1e7b4d0a11 at 0xdeadbeef",
+			e: "commit <hex> at <hex>",
+		},
+		"short-hex": {
+			l: "cafe deadbeef",
+			e: "cafe deadbeef",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.MaskLogLine(u.l))
+		})
+	}
+}
+
+func TestLogItemsPatterns(t *testing.T) {
+	ii := dao.NewLogItems()
+	ii.Add(
+		dao.NewLogItemFromString("2025-01-01T10:00:00Z GET /users/1 200"),
+		dao.NewLogItemFromString("2025-01-01T10:00:01Z connection reset by 10.0.0.1"),
+		dao.NewLogItemFromString("2025-01-01T10:00:02Z GET /users/22 200"),
+		dao.NewLogItemFromString("2025-01-01T10:00:03Z GET /users/333 404"),
+	)
+
+	pp := ii.Patterns()
+	assert.Len(t, pp, 2)
+
+	p := pp[0]
+	assert.Equal(t, "GET /users/<num> <num>", p.Template)
+	assert.Equal(t, 3, p.Count)
+	assert.Equal(t, time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC), p.FirstSeen)
+	assert.Equal(t, time.Date(2025, 1, 1, 10, 0, 3, 0, time.UTC), p.LastSeen)
+	assert.Len(t, p.Lines, 3)
+	assert.Equal(t, "2025-01-01T10:00:00Z GET /users/1 200", p.Lines[0])
+
+	assert.Equal(t, "connection reset by <ip>", pp[1].Template)
+	assert.Equal(t, 1, pp[1].Count)
+}
@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"cmp"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var predicateRX = regexp.MustCompile(`^([A-Za-z_@][\w.@\-]*)(!=|>=|<=|!~|=|>|<|~)(.*)$`)
+
+// LogPredicate represents a structured log field predicate ie level=error or latency>500ms.
+type LogPredicate struct {
+	Field, Op, Value string
+
+	rx *regexp.Regexp
+}
+
+// ParseLogPredicates parses a white space separated list of field predicates.
+// Returns false if the query is not a predicates query.
+func ParseLogPredicates(q string) ([]LogPredicate, bool) {
+	tokens, ok := splitQuoted(strings.TrimSpace(q))
+	if !ok || len(tokens) == 0 {
+		return nil, false
+	}
+	pp := make([]LogPredicate, 0, len(tokens))
+	for _, t := range tokens {
+		mm := predicateRX.FindStringSubmatch(t)
+		if mm == nil {
+			return nil, false
+		}
+		p := LogPredicate{Field: mm[1], Op: mm[2], Value: strings.Trim(mm[3], `"`)}
+		if p.Op == "~" || p.Op == "!~" {
+			rx, err := regexp.Compile(`(?i)` + p.Value)
+			if err != nil {
+				return nil, false
+			}
+			p.rx = rx
+		}
+		if slices.Contains(levelKeys, p.Field) {
+			p.Value = NormalizeLevel(p.Value)
+		}
+		pp = append(pp, p)
+	}
+
+	return pp, true
+}
+
+// Match checks if a log record satisfies the predicate.
+func (p LogPredicate) Match(r *LogRecord) bool {
+	v, ok := r.Field(p.Field)
+	if !ok {
+		return p.Op == "!=" || p.Op == "!~"
+	}
+
+	switch p.Op {
+	case "=":
+		return strings.EqualFold(v, p.Value) || compareEqual(v, p.Value)
+	case "!=":
+		return !strings.EqualFold(v, p.Value) && !compareEqual(v, p.Value)
+	case "~":
+		return p.rx.MatchString(v)
+	case "!~":
+		return !p.rx.MatchString(v)
+	}
+	c, ok := compareValues(v, p.Value)
+	if !ok {
+		return false
+	}
+	switch p.Op {
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	default:
+		return false
+	}
+}
+
+// MatchAll checks if a log record satisfies all given predicates.
+func MatchAll(r *LogRecord, pp []LogPredicate) bool {
+	for _, p := range pp {
+		if !p.Match(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func compareEqual(a, b string) bool {
+	c, ok := compareValues(a, b)
+	return ok && c == 0
+}
+
+// compareValues compares two values as durations or numbers.
+func compareValues(a, b string) (int, bool) {
+	if da, err := time.ParseDuration(a); err == nil {
+		if db, err := time.ParseDuration(b); err == nil {
+			return cmp.Compare(da, db), true
+		}
+	}
+	fa, err := strconv.ParseFloat(a, 64)
+	if err != nil {
+		return 0, false
+	}
+	fb, err := strconv.ParseFloat(b, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return cmp.Compare(fa, fb), true
+}
+
+// splitQuoted splits a string on white spaces while preserving quoted values.
+func splitQuoted(s string) ([]string, bool) {
+	var (
+		tokens []string
+		buff   strings.Builder
+		quoted bool
+	)
+	for _, r := range s {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			buff.WriteRune(r)
+		case (r == ' ' || r == '\t') && !quoted:
+			if buff.Len() > 0 {
+				tokens = append(tokens, buff.String())
+				buff.Reset()
+			}
+		default:
+			buff.WriteRune(r)
+		}
+	}
+	if quoted {
+		return nil, false
+	}
+	if buff.Len() > 0 {
+		tokens = append(tokens, buff.String())
+	}
+
+	return tokens, true
+}
@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var (
+	levelKeys   = []string{"level", "lvl", "severity", "loglevel", "log.level"}
+	messageKeys = []string{"msg", "message"}
+	timeKeys    = []string{"time", "ts", "timestamp", "@timestamp"}
+
+	levelAliases = map[string]string{
+		"WARNING":     "WARN",
+		"ERR":         "ERROR",
+		"INFORMATION": "INFO",
+		"DBG":         "DEBUG",
+		"CRITICAL":    "CRIT",
+	}
+)
+
+// LogRecord represents a structured log line.
+type LogRecord struct {
+	Time, Level, Message string
+
+	// Fields tracks all record fields keyed by their flattened name.
+	Fields map[string]string
+
+	// Keys tracks the extra field names ie not time, level or message in rendering order.
+	Keys []string
+}
+
+// ParseLogRecord parses a JSON or logfmt log line.
+// Returns false if the line is not structured.
+func ParseLogRecord(bb []byte) (*LogRecord, bool) {
+	bb = bytes.TrimSpace(bb)
+	if len(bb) == 0 {
+		return nil, false
+	}
+	if bb[0] == '{' {
+		return parseJSONRecord(bb)
+	}
+
+	return parseLogfmtRecord(bb)
+}
+
+// Field returns a record field value. Level, message and time aliases are resolved.
+func (r *LogRecord) Field(k string) (string, bool) {
+	switch {
+	case slices.Contains(levelKeys, k):
+		return r.Level, r.Level != ""
+	case slices.Contains(messageKeys, k):
+		return r.Message, r.Message != ""
+	case slices.Contains(timeKeys, k):
+		return r.Time, r.Time != ""
+	}
+	v, ok := r.Fields[k]
+
+	return v, ok
+}
+
+// NormalizeLevel returns a canonical log level.
+func NormalizeLevel(l string) string {
+	l = strings.ToUpper(strings.TrimSpace(l))
+	if a, ok := levelAliases[l]; ok {
+		return a
+	}
+
+	return l
+}
+
+func newLogRecord(fields map[string]string, keys []string) (*LogRecord, bool) {
+	r := LogRecord{Fields: fields}
+	var known bool
+	for _, k := range keys {
+		v := fields[k]
+		switch {
+		case slices.Contains(levelKeys, k) && r.Level == "":
+			r.Level, known = NormalizeLevel(v), true
+		case slices.Contains(messageKeys, k) && r.Message == "":
+			r.Message, known = v, true
+		case slices.Contains(timeKeys, k) && r.Time == "":
+			r.Time = v
+		default:
+			r.Keys = append(r.Keys, k)
+		}
+	}
+
+	return &r, known
+}
+
+func parseJSONRecord(bb []byte) (*LogRecord, bool) {
+	d := json.NewDecoder(bytes.NewReader(bb))
+	d.UseNumber()
+	var mm map[string]any
+	if err := d.Decode(&mm); err != nil {
+		return nil, false
+	}
+	fields := make(map[string]string, len(mm))
+	flattenFields("", mm, fields)
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	r, _ := newLogRecord(fields, keys)
+
+	return r, true
+}
+
+func flattenFields(prefix string, mm map[string]any, fields map[string]string) {
+	for k, v := range mm {
+		if prefix != "" {
+			k = prefix + "." + k
+		}
+		switch t := v.(type) {
+		case map[string]any:
+			flattenFields(k, t, fields)
+		case string:
+			fields[k] = t
+		case nil:
+			fields[k] = "null"
+		case json.Number, bool:
+			fields[k] = fmt.Sprintf("%v", t)
+		default:
+			raw, err := json.Marshal(t)
+			if err != nil {
+				continue
+			}
+			fields[k] = string(raw)
+		}
+	}
+}
+
+// parseLogfmtRecord parses a logfmt line ie level=info msg="hello world".
+// A line qualifies if it only contains key/value pairs including a level or message.
+func parseLogfmtRecord(bb []byte) (*LogRecord, bool) {
+	var (
+		s      = string(bb)
+		fields = make(map[string]string)
+		keys   []string
+	)
+	for s != "" {
+		s = strings.TrimLeft(s, " \t")
+		if s == "" {
+			break
+		}
+		eq := strings.IndexAny(s, "= \t\"")
+		if eq <= 0 || s[eq] != '=' {
+			return nil, false
+		}
+		k, v := s[:eq], ""
+		s = s[eq+1:]
+		if strings.HasPrefix(s, `"`) {
+			end := closingQuote(s)
+			if end < 0 {
+				return nil, false
+			}
+			var err error
+			if v, err = unquote(s[:end+1]); err != nil {
+				return nil, false
+			}
+			s = s[end+1:]
+		} else {
+			end := strings.IndexAny(s, " \t")
+			if end < 0 {
+				end = len(s)
+			}
+			v, s = s[:end], s[end:]
+		}
+		if _, ok := fields[k]; !ok {
+			keys = append(keys, k)
+		}
+		fields[k] = v
+	}
+
+	return newLogRecord(fields, keys)
+}
+
+func closingQuote(s string) int {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+
+	return -1
+}
+
+func unquote(s string) (string, error) {
+	var v string
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return "", err
+	}
+
+	return v, nil
+}
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLogRecord(t *testing.T) {
+	uu := map[string]struct {
+		line string
+		ok   bool
+		e    *dao.LogRecord
+	}{
+		"empty": {},
+		"plain": {
+			line: "Testing 1,2,3...",
+		},
+		"json": {
+			line: `{"level":"warning","msg":"blee","ts":"2025-01-01T00:00:00Z","latency":"512ms","http":{"status":500}}`,
+			ok:   true,
+			e: &dao.LogRecord{
+				Time:    "2025-01-01T00:00:00Z",
+				Level:   "WARN",
+				Message: "blee",
+				Fields: map[string]string{
+					"level":       "warning",
+					"msg":         "blee",
+					"ts":          "2025-01-01T00:00:00Z",
+					"latency":     "512ms",
+					"http.status": "500",
+				},
+				Keys: []string{"http.status", "latency"},
+			},
+		},
+		"json-invalid": {
+			line: `{"level":"info"`,
+		},
+		"logfmt": {
+			line: `time=2025-01-01T00:00:00Z level=error msg="connection refused" retries=3`,
+			ok:   true,
+			e: &dao.LogRecord{
+				Time:    "2025-01-01T00:00:00Z",
+				Level:   "ERROR",
+				Message: "connection refused",
+				Fields: map[string]string{
+					"time":    "2025-01-01T00:00:00Z",
+					"level":   "error",
+					"msg":     "connection refused",
+					"retries": "3",
+				},
+				Keys: []string{"retries"},
+			},
+		},
+		"logfmt-no-level": {
+			line: `a=1 b=2`,
+		},
+		"logfmt-unclosed": {
+			line: `level=info msg="blee`,
+		},
+		"sentence": {
+			line: `level is info today`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r, ok := dao.ParseLogRecord([]byte(u.line))
+			assert.Equal(t, u.ok, ok)
+			if u.ok {
+				assert.Equal(t, u.e, r)
+			}
+		})
+	}
+}
+
+func TestLogPredicates(t *testing.T) {
+	r, ok := dao.ParseLogRecord([]byte(`{"level":"error","msg":"boom","latency":"1.2s","code":503,"path":"/api/v1"}`))
+	assert.True(t, ok)
+
+	uu := map[string]struct {
+		q     string
+		valid bool
+		e     bool
+	}{
+		"level":        {q: "level=error", valid: true, e: true},
+		"level-alias":  {q: "lvl=ERR", valid: true, e: true},
+		"level-not":    {q: "level!=error", valid: true},
+		"duration-gt":  {q: "latency>500ms", valid: true, e: true},
+		"duration-lt":  {q: "latency<500ms", valid: true},
+		"number-ge":    {q: "code>=500", valid: true, e: true},
+		"number-mixed": {q: "code>500ms", valid: true},
+		"regex":        {q: "path~^/api", valid: true, e: true},
+		"not-regex":    {q: "path!~^/api", valid: true},
+		"and":          {q: "level=error code=503", valid: true, e: true},
+		"and-fail":     {q: "level=error code=200", valid: true},
+		"missing":      {q: "user=fred", valid: true},
+		"missing-not":  {q: "user!=fred", valid: true, e: true},
+		"quoted":       {q: `msg="boom"`, valid: true, e: true},
+		"plain":        {q: "boom"},
+		"bad-rx":       {q: "path~("},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pp, ok := dao.ParseLogPredicates(u.q)
+			assert.Equal(t, u.valid, ok)
+			if u.valid {
+				assert.Equal(t, u.e, dao.MatchAll(r, pp))
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
+)
+
+var metricsHeader = []string{
+	"TIME", "TYPE", "NAMESPACE",
+	"CPU", "MEM",
+	"ALLOCATABLE_CPU", "ALLOCATABLE_MEM",
+	"TOTAL_CPU", "TOTAL_MEM",
+}
+
+// MetricsStore persists metrics time series to disk as csv.
+type MetricsStore struct {
+	path      string
+	retention time.Duration
+	mx        sync.Mutex
+}
+
+// NewMetricsStore returns a new store.
+func NewMetricsStore(path string, retention time.Duration) *MetricsStore {
+	return &MetricsStore{
+		path:      path,
+		retention: retention,
+	}
+}
+
+// Path returns the store file path.
+func (s *MetricsStore) Path() string {
+	return s.path
+}
+
+// Retention returns how long metrics are kept around.
+func (s *MetricsStore) Retention() time.Duration {
+	return s.retention
+}
+
+// Append adds a point to the store.
+func (s *MetricsStore) Append(pt Point) error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if err := data.EnsureDirPath(s.path, data.DefaultDirMod); err != nil {
+		return err
+	}
+	_, err := os.Stat(s.path)
+	fresh := errors.Is(err, fs.ErrNotExist)
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, data.DefaultFileMod)
+	if err != nil {
+		return err
+	}
+	w := csv.NewWriter(f)
+	if fresh {
+		_ = w.Write(metricsHeader)
+	}
+	_ = w.Write(pointToRecord(pt))
+	w.Flush()
+	if err := w.Error(); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// Load returns all points recorded since the given time.
+func (s *MetricsStore) Load(since time.Time) (TimeSeries, error) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	return s.load(since)
+}
+
+// Compact drops points older than the store retention.
+func (s *MetricsStore) Compact(now time.Time) error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	ts, err := s.load(now.Add(-s.retention))
+	if err != nil || ts == nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, data.DefaultFileMod)
+	if err != nil {
+		return err
+	}
+	if err := WriteSeriesCSV(f, ts); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, s.path)
+}
+
+func (s *MetricsStore) load(since time.Time) (TimeSeries, error) {
+	f, err := os.Open(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = len(metricsHeader)
+	ts := make(TimeSeries, 0, 100)
+	for {
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			// Skip partially written records ie k9s was killed mid write.
+			continue
+		}
+		pt, ok := recordToPoint(rec)
+		if !ok || pt.Time.Before(since) {
+			continue
+		}
+		ts = append(ts, pt)
+	}
+
+	return ts, nil
+}
+
+// WriteSeriesCSV writes out a time series as csv.
+func WriteSeriesCSV(w io.Writer, ts TimeSeries) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(metricsHeader); err != nil {
+		return err
+	}
+	for _, pt := range ts {
+		if err := cw.Write(pointToRecord(pt)); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// ExportSeries writes out a time series as csv in the given directory and returns the file path.
+func ExportSeries(dir, name string, ts TimeSeries) (string, error) {
+	if err := data.EnsureFullPath(dir, data.DefaultDirMod); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, data.SanitizeFileName(fmt.Sprintf("%s-%d.csv", name, time.Now().UnixNano())))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, data.DefaultFileMod)
+	if err != nil {
+		return "", err
+	}
+	if err := WriteSeriesCSV(f, ts); err != nil {
+		_ = f.Close()
+		return "", err
+	}
+
+	return path, f.Close()
+}
+
+func pointToRecord(pt Point) []string {
+	return []string{
+		pt.Time.UTC().Format(time.RFC3339),
+		pt.Tags["type"],
+		pt.Tags["namespace"],
+		strconv.FormatInt(pt.Value.CurrentCPU, 10),
+		strconv.FormatInt(pt.Value.CurrentMEM, 10),
+		strconv.FormatInt(pt.Value.AllocatableCPU, 10),
+		strconv.FormatInt(pt.Value.AllocatableMEM, 10),
+		strconv.FormatInt(pt.Value.TotalCPU, 10),
+		strconv.FormatInt(pt.Value.TotalMEM, 10),
+	}
+}
+
+func recordToPoint(rec []string) (Point, bool) {
+	t, err := time.Parse(time.RFC3339, rec[0])
+	if err != nil {
+		return Point{}, false
+	}
+	var nn [6]int64
+	for i := range nn {
+		if nn[i], err = strconv.ParseInt(rec[i+3], 10, 64); err != nil {
+			return Point{}, false
+		}
+	}
+	pt := Point{
+		Time: t,
+		Tags: map[string]string{"type": rec[1]},
+		Value: client.NodeMetrics{
+			AllocatableCPU: nn[2],
+			AllocatableMEM: nn[3],
+			TotalCPU:       nn[4],
+			TotalMEM:       nn[5],
+		},
+	}
+	if rec[2] != "" {
+		pt.Tags["namespace"] = rec[2]
+	}
+	pt.Value.CurrentCPU, pt.Value.CurrentMEM = nn[0], nn[1]
+
+	return pt, true
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makePoint(t time.Time, kind, ns string, cpu int64) dao.Point {
+	pt := dao.Point{
+		Time: t,
+		Tags: map[string]string{"type": kind},
+		Value: client.NodeMetrics{
+			AllocatableCPU: 1000,
+			AllocatableMEM: 2000,
+		},
+	}
+	if ns != "" {
+		pt.Tags["namespace"] = ns
+	}
+	pt.Value.CurrentCPU, pt.Value.CurrentMEM = cpu, cpu*2
+
+	return pt
+}
+
+func TestMetricsStore(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	path := filepath.Join(t.TempDir(), "c1", "metrics.csv")
+	s := dao.NewMetricsStore(path, 24*time.Hour)
+
+	require.NoError(t, s.Append(makePoint(now.Add(-48*time.Hour), "node", "", 10)))
+	require.NoError(t, s.Append(makePoint(now.Add(-2*time.Hour), "node", "", 20)))
+	require.NoError(t, s.Append(makePoint(now.Add(-time.Hour), "pod", "fred", 30)))
+
+	ts, err := s.Load(now.Add(-24 * time.Hour))
+	require.NoError(t, err)
+	assert.Len(t, ts, 2)
+	assert.Equal(t, makePoint(now.Add(-2*time.Hour), "node", "", 20), ts[0])
+	assert.Equal(t, makePoint(now.Add(-time.Hour), "pod", "fred", 30), ts[1])
+
+	require.NoError(t, s.Compact(now))
+	ts, err = s.Load(time.Time{})
+	require.NoError(t, err)
+	assert.Len(t, ts, 2)
+
+	bb, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, 3, bytes.Count(bb, []byte("\n")))
+}
+
+func TestMetricsStoreMissing(t *testing.T) {
+	s := dao.NewMetricsStore(filepath.Join(t.TempDir(), "metrics.csv"), time.Hour)
+
+	ts, err := s.Load(time.Time{})
+	require.NoError(t, err)
+	assert.Empty(t, ts)
+	require.NoError(t, s.Compact(time.Now()))
+}
+
+func TestWriteSeriesCSV(t *testing.T) {
+	at := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	var bb bytes.Buffer
+	require.NoError(t, dao.WriteSeriesCSV(&bb, dao.TimeSeries{makePoint(at, "pod", "fred", 5)}))
+
+	e := "TIME,TYPE,NAMESPACE,CPU,MEM,ALLOCATABLE_CPU,ALLOCATABLE_MEM,TOTAL_CPU,TOTAL_MEM\n" +
+		"2025-01-01T10:00:00Z,pod,fred,5,10,1000,2000,0,0\n"
+	assert.Equal(t, e, bb.String())
+}
+
+func TestRecorderSeriesHistory(t *testing.T) {
+	dao.ResetRecorder(nil)
+	r := dao.DialRecorder(nil)
+	t.Cleanup(func() { dao.ResetRecorder(nil) })
+
+	now := time.Now().UTC().Truncate(time.Second)
+	path := filepath.Join(t.TempDir(), "c1", "metrics.csv")
+	s := dao.NewMetricsStore(path, 24*time.Hour)
+	require.NoError(t, s.Append(makePoint(now.Add(-48*time.Hour), "node", "", 10)))
+	require.NoError(t, s.Append(makePoint(now.Add(-2*time.Hour), "node", "", 20)))
+	require.NoError(t, s.Append(makePoint(now.Add(-time.Hour), "pod", "fred", 30)))
+	r.SetStore(s)
+
+	// History is only read from disk when the store is set.
+	require.NoError(t, os.Remove(path))
+	assert.Equal(t, dao.TimeSeries{makePoint(now.Add(-2*time.Hour), "node", "", 20)}, r.Series(client.NamespaceAll))
+	assert.Equal(t, dao.TimeSeries{makePoint(now.Add(-time.Hour), "pod", "fred", 30)}, r.Series("fred"))
+	assert.Empty(t, r.Series("blee"))
+}
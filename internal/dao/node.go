@@ -159,7 +159,12 @@ func (n *Node) Get(ctx context.Context, path string) (runtime.Object, error) {
 		}
 	}
 
-	return &render.NodeWithMetrics{Raw: raw, MX: nmx, PodCount: podCount}, nil
+	var trend *render.Trend
+	if nmx != nil {
+		trend = DialRecorder(n.Client()).TrackNode(nmx)
+	}
+
+	return &render.NodeWithMetrics{Raw: raw, MX: nmx, PodCount: podCount, Trend: trend}, nil
 }
 
 // List returns a collection of node resources.
@@ -182,6 +187,7 @@ func (n *Node) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 			slog.Error("Unable to list pods", slogs.Error, err)
 		}
 	}
+	rec := DialRecorder(n.Client())
 	res := make([]runtime.Object, 0, len(oo))
 	for _, o := range oo {
 		u, ok := o.(*unstructured.Unstructured)
@@ -205,6 +211,7 @@ func (n *Node) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 			Raw:      u,
 			MX:       nmx[name],
 			PodCount: podCount,
+			Trend:    rec.TrackNode(nmx[name]),
 		})
 	}
 
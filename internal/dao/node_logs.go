@@ -0,0 +1,216 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/tview"
+	restclient "k8s.io/client-go/rest"
+)
+
+var _ Loggable = (*Node)(nil)
+
+const (
+	// nodeLogPollInterval tracks how often journal units are queried when following node logs.
+	nodeLogPollInterval = 5 * time.Second
+
+	// journalTimeFmt tracks the journalctl short-precise timestamp format used by the kubelet.
+	journalTimeFmt = "Jan 2 15:04:05.999999"
+)
+
+// IsNodeLogFile checks if a node log source is a file under the node log dir vs a journal unit.
+func IsNodeLogFile(src string) bool {
+	return strings.Contains(src, "/") || strings.HasSuffix(src, ".log")
+}
+
+// TailLogs tails a node journal unit or log file via the kubelet logs proxy.
+// The log source is specified via the options container field.
+func (n *Node) TailLogs(ctx context.Context, opts *LogOptions) ([]LogChan, error) {
+	if opts.Container == "" {
+		return nil, errors.New("no node log unit or file specified")
+	}
+	auth, err := n.Client().CanI(client.ClusterScope, client.NewGVR(client.NodeGVR.String()+":proxy"), opts.Path, client.GetAccess)
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to view node logs")
+	}
+	opts.SingleContainer = true
+
+	bufSize := opts.LogBufferSize
+	if bufSize <= 0 {
+		bufSize = logChannelBuffer
+	}
+	out := make(LogChan, bufSize)
+	go func() {
+		defer close(out)
+		n.pollNodeLogs(ctx, opts, out)
+	}()
+
+	return []LogChan{out}, nil
+}
+
+func (n *Node) pollNodeLogs(ctx context.Context, opts *LogOptions, out LogChan) {
+	var last time.Time
+	for {
+		req, err := n.nodeLogsRequest(opts, last)
+		if err != nil {
+			out <- opts.ToErrLogItem(err)
+			return
+		}
+		stream, err := req.Stream(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				slog.Error("Node logs request failed",
+					slogs.Container, opts.Info(),
+					slogs.Error, err,
+				)
+				out <- opts.ToErrLogItem(fmt.Errorf("node logs query failed: %w", err))
+			}
+			return
+		}
+		var done bool
+		last, done = readNodeLogs(ctx, stream, out, opts, last)
+		if done || !followNodeLogs(opts) {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(nodeLogPollInterval):
+		}
+	}
+}
+
+func followNodeLogs(opts *LogOptions) bool {
+	if opts.Head || IsNodeLogFile(opts.Container) {
+		return false
+	}
+
+	return opts.EndTime.IsZero() || time.Now().Before(opts.EndTime)
+}
+
+func (n *Node) nodeLogsRequest(opts *LogOptions, since time.Time) (*restclient.Request, error) {
+	dial, err := n.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	_, node := client.Namespaced(opts.Path)
+	base := "/api/v1/nodes/" + node + "/proxy/logs/"
+	if IsNodeLogFile(opts.Container) {
+		return dial.CoreV1().RESTClient().Get().AbsPath(base + strings.TrimPrefix(opts.Container, "/var/log/")), nil
+	}
+
+	req := dial.CoreV1().RESTClient().Get().AbsPath(base).Param("query", opts.Container)
+	switch {
+	case !since.IsZero():
+		req = req.Param("sinceTime", since.UTC().Format(time.RFC3339))
+	case opts.HasRange():
+		req = req.Param("sinceTime", opts.StartTime.UTC().Format(time.RFC3339))
+	case opts.SinceSeconds > 0:
+		req = req.Param("sinceTime", time.Now().Add(-time.Duration(opts.SinceSeconds)*time.Second).UTC().Format(time.RFC3339))
+	case opts.Lines > 0:
+		req = req.Param("tailLines", strconv.FormatInt(opts.Lines, 10))
+	}
+	if !opts.EndTime.IsZero() {
+		req = req.Param("untilTime", opts.EndTime.UTC().Format(time.RFC3339))
+	}
+
+	return req, nil
+}
+
+// readNodeLogs emits node log lines newer than the last seen entry. It returns the
+// latest entry time and whether the stream should no longer be polled.
+func readNodeLogs(ctx context.Context, stream io.ReadCloser, out LogChan, opts *LogOptions, last time.Time) (time.Time, bool) {
+	defer func() {
+		if err := stream.Close(); err != nil {
+			slog.Error("Failed to close node logs stream",
+				slogs.Container, opts.Info(),
+				slogs.Error, err,
+			)
+		}
+	}()
+
+	r := bufio.NewReader(stream)
+	for {
+		bb, err := r.ReadBytes('\n')
+		if len(bb) > 0 {
+			t, line, ok := NormalizeNodeLogLine(bb, time.Now())
+			// Subsequent polls overlap the previous ones so skip entries we've already seen.
+			if !last.IsZero() && (!ok || !t.After(last)) {
+				continue
+			}
+			if ok {
+				last = t
+			}
+			if opts.IsPastRange(line) {
+				return last, true
+			}
+			select {
+			case <-ctx.Done():
+				return last, true
+			case out <- opts.ToLogItem(tview.EscapeBytes(line)):
+			}
+		}
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+			slog.Warn("Node logs stream failed",
+				slogs.Container, opts.Info(),
+				slogs.Error, err,
+			)
+		}
+		return last, ctx.Err() != nil
+	}
+}
+
+// NormalizeNodeLogLine prefixes a node log line with an RFC3339 timestamp. Journal entries use
+// their own timestamp, assumed to be UTC, other lines use the given time.
+func NormalizeNodeLogLine(bb []byte, now time.Time) (time.Time, []byte, bool) {
+	s := strings.TrimRight(string(bb), "\r\n")
+	t, msg, ok := parseJournalLine(s, now)
+	if !ok {
+		t, msg = now, s
+	}
+
+	return t, []byte(t.UTC().Format(time.RFC3339Nano) + " " + msg + "\n"), ok
+}
+
+func parseJournalLine(s string, now time.Time) (time.Time, string, bool) {
+	ff := strings.SplitN(strings.TrimLeft(s, " "), " ", 4)
+	if len(ff) < 4 {
+		return time.Time{}, "", false
+	}
+	// Journal days may be space padded ie Jan  2.
+	if ff[1] == "" {
+		ff = strings.SplitN(strings.TrimLeft(s, " "), " ", 5)
+		if len(ff) < 5 {
+			return time.Time{}, "", false
+		}
+		ff = append([]string{ff[0]}, ff[2:]...)
+	}
+	t, err := time.ParseInLocation(journalTimeFmt, strings.Join(ff[:3], " "), time.UTC)
+	if err != nil {
+		return time.Time{}, "", false
+	}
+	t = t.AddDate(now.UTC().Year(), 0, 0)
+	if t.After(now.Add(24 * time.Hour)) {
+		t = t.AddDate(-1, 0, 0)
+	}
+
+	return t, ff[3], true
+}
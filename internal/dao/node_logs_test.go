@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeNodeLogLine(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	uu := map[string]struct {
+		l, e string
+		ok   bool
+	}{
+		"journal": {
+			l:  "Mar 10 11:58:02.123456 node-1 kubelet[812]: Node became not ready\n",
+			e:  "2025-03-10T11:58:02.123456Z node-1 kubelet[812]: Node became not ready\n",
+			ok: true,
+		},
+		"journal-padded": {
+			l:  "Mar  9 23:00:00.000001 node-1 containerd[50]: started",
+			e:  "2025-03-09T23:00:00.000001Z node-1 containerd[50]: started\n",
+			ok: true,
+		},
+		"last-year": {
+			l:  "Dec 31 23:59:59.000000 node-1 kubelet[1]: bye",
+			e:  "2024-12-31T23:59:59Z node-1 kubelet[1]: bye\n",
+			ok: true,
+		},
+		"plain": {
+			l: "I0310 11:58:02.123456 812 kubelet.go:2000] fred\n",
+			e: "2025-03-10T12:00:00Z I0310 11:58:02.123456 812 kubelet.go:2000] fred\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			_, bb, ok := NormalizeNodeLogLine([]byte(u.l), now)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, string(bb))
+		})
+	}
+}
+
+func TestReadNodeLogs_SkipsSeen(t *testing.T) {
+	t0 := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	entry := func(d time.Duration, msg string) string {
+		return t0.Add(d).Format(journalTimeFmt) + " n1 kubelet[1]: " + msg + "\n"
+	}
+	input := entry(0, "one") + entry(time.Second, "two") + "-- No entries --\n" + entry(2*time.Second, "three")
+	stream := io.NopCloser(strings.NewReader(input))
+	out := make(chan *LogItem, 10)
+	opts := &LogOptions{Path: "n1", Container: "kubelet", SingleContainer: true}
+
+	latest, done := readNodeLogs(context.Background(), stream, out, opts, t0)
+	close(out)
+
+	assert.False(t, done)
+	assert.Equal(t, t0.Add(2*time.Second), latest)
+	var lines []string
+	for item := range out {
+		assert.Equal(t, "kubelet", item.Container)
+		lines = append(lines, string(item.Bytes))
+	}
+	assert.Len(t, lines, 2)
+	assert.True(t, strings.HasSuffix(lines[0], "two\n"))
+	assert.True(t, strings.HasSuffix(lines[1], "three\n"))
+}
+
+func TestIsNodeLogFile(t *testing.T) {
+	assert.False(t, IsNodeLogFile("kubelet"))
+	assert.True(t, IsNodeLogFile("kube-proxy.log"))
+	assert.True(t, IsNodeLogFile("pods/fred.log"))
+}
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ReadyPodFor returns a ready pod backing a given resource. The excluded pod
+// is only picked when no other pod is ready.
+func ReadyPodFor(f Factory, gvr *client.GVR, path, exclude string) (string, error) {
+	o, err := f.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return "", fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	sel, err := podSelectorFor(gvr, u)
+	if err != nil {
+		return "", err
+	}
+	oo, err := f.List(client.PodGVR, u.GetNamespace(), true, sel)
+	if err != nil {
+		return "", err
+	}
+	pp, err := toTyped[v1.Pod](oo)
+	if err != nil {
+		return "", err
+	}
+
+	return pickReadyPod(pp, strings.Split(exclude, "|")[0])
+}
+
+func podSelectorFor(gvr *client.GVR, u *unstructured.Unstructured) (labels.Selector, error) {
+	if gvr == client.SvcGVR {
+		m, _, err := unstructured.NestedStringMap(u.Object, "spec", "selector")
+		if err != nil {
+			return nil, err
+		}
+		if len(m) == 0 {
+			return nil, fmt.Errorf("no pod selector for %s %s", gvr, u.GetName())
+		}
+		return labels.SelectorFromSet(m), nil
+	}
+
+	m, ok, err := unstructured.NestedMap(u.Object, "spec", "selector")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("no pod selector for %s %s", gvr, u.GetName())
+	}
+	var ls metav1.LabelSelector
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &ls); err != nil {
+		return nil, err
+	}
+
+	return metav1.LabelSelectorAsSelector(&ls)
+}
+
+// pickReadyPod returns the newest running and ready pod favoring pods other than exclude.
+func pickReadyPod(pp []v1.Pod, exclude string) (string, error) {
+	pp = slices.DeleteFunc(pp, func(p v1.Pod) bool {
+		return p.DeletionTimestamp != nil || p.Status.Phase != v1.PodRunning || !isPodReady(&p)
+	})
+	if len(pp) == 0 {
+		return "", fmt.Errorf("no ready pods found")
+	}
+	slices.SortFunc(pp, func(a, b v1.Pod) int {
+		return b.CreationTimestamp.Compare(a.CreationTimestamp.Time)
+	})
+	for _, p := range pp {
+		if fqn := client.FQN(p.Namespace, p.Name); fqn != exclude {
+			return fqn, nil
+		}
+	}
+
+	return client.FQN(pp[0].Namespace, pp[0].Name), nil
+}
+
+func isPodReady(p *v1.Pod) bool {
+	for _, c := range p.Status.Conditions {
+		if c.Type == v1.PodReady {
+			return c.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestPickReadyPod(t *testing.T) {
+	now := time.Now()
+	uu := map[string]struct {
+		pp      []v1.Pod
+		exclude string
+		e       string
+		err     bool
+	}{
+		"empty": {
+			err: true,
+		},
+		"none-ready": {
+			pp: []v1.Pod{
+				makeFollowPod("p1", now, v1.PodRunning, false),
+				makeFollowPod("p2", now, v1.PodPending, true),
+			},
+			err: true,
+		},
+		"newest": {
+			pp: []v1.Pod{
+				makeFollowPod("p1", now.Add(-time.Hour), v1.PodRunning, true),
+				makeFollowPod("p2", now, v1.PodRunning, true),
+			},
+			e: "default/p2",
+		},
+		"exclude": {
+			pp: []v1.Pod{
+				makeFollowPod("p1", now.Add(-time.Hour), v1.PodRunning, true),
+				makeFollowPod("p2", now, v1.PodRunning, true),
+			},
+			exclude: "default/p2",
+			e:       "default/p1",
+		},
+		"exclude-only": {
+			pp: []v1.Pod{
+				makeFollowPod("p1", now, v1.PodRunning, true),
+			},
+			exclude: "default/p1",
+			e:       "default/p1",
+		},
+		"terminating": {
+			pp: []v1.Pod{
+				makeFollowPod("p1", now.Add(-time.Hour), v1.PodRunning, true),
+				func() v1.Pod {
+					p := makeFollowPod("p2", now, v1.PodRunning, true)
+					p.DeletionTimestamp = &metav1.Time{Time: now}
+					return p
+				}(),
+			},
+			e: "default/p1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			path, err := pickReadyPod(u.pp, u.exclude)
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.e, path)
+		})
+	}
+}
+
+func TestPodSelectorFor(t *testing.T) {
+	uu := map[string]struct {
+		gvr *client.GVR
+		o   map[string]any
+		e   string
+		err bool
+	}{
+		"svc": {
+			gvr: client.SvcGVR,
+			o: map[string]any{
+				"spec": map[string]any{"selector": map[string]any{"app": "fred"}},
+			},
+			e: "app=fred",
+		},
+		"svc-no-selector": {
+			gvr: client.SvcGVR,
+			o:   map[string]any{"spec": map[string]any{}},
+			err: true,
+		},
+		"dp": {
+			gvr: client.DpGVR,
+			o: map[string]any{
+				"spec": map[string]any{
+					"selector": map[string]any{
+						"matchLabels": map[string]any{"app": "fred"},
+					},
+				},
+			},
+			e: "app=fred",
+		},
+		"dp-no-selector": {
+			gvr: client.DpGVR,
+			o:   map[string]any{"spec": map[string]any{}},
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			sel, err := podSelectorFor(u.gvr, &unstructured.Unstructured{Object: u.o})
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.e, sel.String())
+		})
+	}
+}
+
+// Helpers...
+
+func makeFollowPod(n string, at time.Time, phase v1.PodPhase, ready bool) v1.Pod {
+	status := v1.ConditionFalse
+	if ready {
+		status = v1.ConditionTrue
+	}
+
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "default",
+			Name:              n,
+			CreationTimestamp: metav1.Time{Time: at},
+		},
+		Status: v1.PodStatus{
+			Phase: phase,
+			Conditions: []v1.PodCondition{
+				{Type: v1.PodReady, Status: status},
+			},
+		},
+	}
+}
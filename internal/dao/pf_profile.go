@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/watch"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*PFProfile)(nil)
+
+// PFProfile represents port-forward profiles.
+type PFProfile struct {
+	NonResource
+}
+
+// List returns a collection of port-forward profiles.
+func (p *PFProfile) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPFProfiles).(string)
+	if !ok {
+		return nil, errors.New("no port-forward profiles file in context")
+	}
+	address, _ := ctx.Value(internal.KeyPFAddress).(string)
+	pp := config.NewPFProfiles()
+	if err := pp.Load(path); err != nil {
+		return nil, err
+	}
+
+	ff := p.getFactory().Forwarders()
+	oo := make([]runtime.Object, 0, len(pp.Profiles))
+	for _, pr := range pp.Profiles {
+		res := render.PFProfileRes{Profile: pr}
+		for _, f := range pr.Forwards {
+			if _, ok := PFProfileForwarder(ff, f, address); ok {
+				res.Active++
+			}
+		}
+		oo = append(oo, res)
+	}
+
+	return oo, nil
+}
+
+// Get fetch a resource.
+func (*PFProfile) Get(context.Context, string) (runtime.Object, error) {
+	return nil, errors.New("nyi")
+}
+
+// ResolvePFTarget returns the pod path to forward to for a given profile forward.
+func ResolvePFTarget(f Factory, fwd config.PFProfileForward) (string, error) {
+	gvr := fwd.TargetGVR()
+	if gvr == client.PodGVR {
+		return fwd.Path, nil
+	}
+	acc, err := AccessorFor(f, gvr)
+	if err != nil {
+		return "", err
+	}
+	ctrl, ok := acc.(Controller)
+	if !ok {
+		return "", fmt.Errorf("unable to resolve pods for %s %s", gvr, fwd.Path)
+	}
+
+	return ctrl.Pod(fwd.Path)
+}
+
+// PFProfileForwarder returns the active forwarder for a profile forward. Forwarders must
+// match the forward target, container, port mapping and address. Forwards with no
+// address use the given default address.
+func PFProfileForwarder(ff watch.Forwarders, fwd config.PFProfileForward, address string) (watch.Forwarder, bool) {
+	pt := fwd.Tunnel(address)
+	target := pfProfileTarget(fwd)
+	for _, f := range ff {
+		if f.Address() != pt.Address || !f.HasPortMapping(pt.PortMap()) {
+			continue
+		}
+		if fwd.Container != "" && f.Container() != fwd.Container {
+			continue
+		}
+		if forwarderTarget(f) == target {
+			return f, true
+		}
+	}
+
+	return nil, false
+}
+
+func pfProfileTarget(fwd config.PFProfileForward) string {
+	if gvr := fwd.TargetGVR(); gvr != client.PodGVR {
+		return gvr.R() + ":" + fwd.Path
+	}
+
+	return fwd.Path
+}
+
+// forwarderTarget returns the followed resource or the forwarded pod path.
+func forwarderTarget(f watch.Forwarder) string {
+	if t, ok := f.(interface{ Target() string }); ok && t.Target() != "" {
+		return t.Target()
+	}
+	path, _, _ := strings.Cut(f.ID(), "|")
+
+	return path
+}
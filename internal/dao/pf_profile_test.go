@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/port"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/tools/portforward"
+)
+
+type fakeForwarder struct {
+	id, target, container, address, portMap string
+}
+
+func (f fakeForwarder) Start(string, port.PortTunnel) (*portforward.PortForwarder, error) {
+	return nil, nil
+}
+func (fakeForwarder) Stop()                          {}
+func (f fakeForwarder) ID() string                   { return f.id }
+func (f fakeForwarder) Target() string               { return f.target }
+func (f fakeForwarder) Container() string            { return f.container }
+func (f fakeForwarder) Port() string                 { return f.portMap }
+func (f fakeForwarder) Address() string              { return f.address }
+func (f fakeForwarder) FQN() string                  { return f.id }
+func (fakeForwarder) Active() bool                   { return true }
+func (fakeForwarder) SetActive(bool)                 {}
+func (fakeForwarder) Age() time.Time                 { return time.Now() }
+func (f fakeForwarder) HasPortMapping(m string) bool { return f.portMap == m }
+
+func TestPFProfileForwarder(t *testing.T) {
+	ff := watch.Forwarders{
+		"fred/p1|c1|9090:8080": fakeForwarder{id: "fred/p1|c1|9090:8080", container: "c1", address: "localhost", portMap: "9090:8080"},
+		"fred/p2|c2|5432:5432": fakeForwarder{id: "fred/p2|c2|5432:5432", target: "deployments:fred/zorg", container: "c2", address: "0.0.0.0", portMap: "5432:5432"},
+	}
+
+	uu := map[string]struct {
+		fwd config.PFProfileForward
+		id  string
+	}{
+		"pod": {
+			fwd: config.PFProfileForward{Path: "fred/p1", ContainerPort: "8080", LocalPort: "9090"},
+			id:  "fred/p1|c1|9090:8080",
+		},
+		"pod-container": {
+			fwd: config.PFProfileForward{Path: "fred/p1", Container: "c1", ContainerPort: "8080", LocalPort: "9090"},
+			id:  "fred/p1|c1|9090:8080",
+		},
+		"follow": {
+			fwd: config.PFProfileForward{GVR: "apps/v1/deployments", Path: "fred/zorg", ContainerPort: "5432", Address: "0.0.0.0"},
+			id:  "fred/p2|c2|5432:5432",
+		},
+		"wrong-path": {
+			fwd: config.PFProfileForward{Path: "fred/blee", ContainerPort: "8080", LocalPort: "9090"},
+		},
+		"wrong-container": {
+			fwd: config.PFProfileForward{Path: "fred/p1", Container: "c2", ContainerPort: "8080", LocalPort: "9090"},
+		},
+		"wrong-port": {
+			fwd: config.PFProfileForward{Path: "fred/p1", ContainerPort: "8081", LocalPort: "9090"},
+		},
+		"wrong-gvr": {
+			fwd: config.PFProfileForward{GVR: "apps/v1/statefulsets", Path: "fred/zorg", ContainerPort: "5432", Address: "0.0.0.0"},
+		},
+		"wrong-address": {
+			fwd: config.PFProfileForward{GVR: "apps/v1/deployments", Path: "fred/zorg", ContainerPort: "5432", Address: "localhost"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f, ok := dao.PFProfileForwarder(ff, u.fwd, "localhost")
+			assert.Equal(t, u.id != "", ok)
+			if ok {
+				assert.Equal(t, u.id, f.ID())
+			}
+		})
+	}
+}
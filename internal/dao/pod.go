@@ -88,12 +88,23 @@ func (p *Pod) Get(ctx context.Context, path string) (runtime.Object, error) {
 		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
 	}
 
-	var pmx *mv1beta1.PodMetrics
+	var (
+		pmx *mv1beta1.PodMetrics
+		xmx client.PodsExtMetricsMap
+	)
 	if withMx, ok := ctx.Value(internal.KeyWithMetrics).(bool); ok && withMx {
-		pmx, _ = client.DialMetrics(p.Client()).FetchPodMetrics(ctx, path)
+		dial := client.DialMetrics(p.Client())
+		pmx, _ = dial.FetchPodMetrics(ctx, path)
+		ns, _ := client.Namespaced(path)
+		xmx, _ = dial.FetchPodsExtMetricsMap(ctx, ns)
+	}
+
+	var trend *render.Trend
+	if pmx != nil {
+		trend = DialRecorder(p.Client()).TrackPod(pmx)
 	}
 
-	return &render.PodWithMetrics{Raw: u, MX: pmx}, nil
+	return &render.PodWithMetrics{Raw: u, MX: pmx, XMX: xmx[path], Trend: trend}, nil
 }
 
 // ListImages lists container images.
@@ -113,9 +124,14 @@ func (p *Pod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 		return oo, err
 	}
 
-	var pmx client.PodsMetricsMap
+	var (
+		pmx client.PodsMetricsMap
+		xmx client.PodsExtMetricsMap
+	)
 	if withMx, ok := ctx.Value(internal.KeyWithMetrics).(bool); ok && withMx {
-		pmx, _ = client.DialMetrics(p.Client()).FetchPodsMetricsMap(ctx, ns)
+		dial := client.DialMetrics(p.Client())
+		pmx, _ = dial.FetchPodsMetricsMap(ctx, ns)
+		xmx, _ = dial.FetchPodsExtMetricsMap(ctx, ns)
 	}
 	sel, _ := ctx.Value(internal.KeyFields).(string)
 	fsel, err := labels.ConvertSelectorToLabelsMap(sel)
@@ -123,6 +139,7 @@ func (p *Pod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 		return nil, err
 	}
 	nodeName := fsel["spec.nodeName"]
+	rec := DialRecorder(p.Client())
 
 	res := make([]runtime.Object, 0, len(oo))
 	for _, o := range oo {
@@ -131,8 +148,10 @@ func (p *Pod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 			return res, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
 		}
 		fqn := extractFQN(o)
+		pwm := render.PodWithMetrics{Raw: u, MX: pmx[fqn], XMX: xmx[fqn]}
 		if nodeName == "" {
-			res = append(res, &render.PodWithMetrics{Raw: u, MX: pmx[fqn]})
+			pwm.Trend = rec.TrackPod(pwm.MX)
+			res = append(res, &pwm)
 			continue
 		}
 
@@ -141,7 +160,8 @@ func (p *Pod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 			return res, fmt.Errorf("expecting interface map but got `%T", o)
 		}
 		if spec["nodeName"] == nodeName {
-			res = append(res, &render.PodWithMetrics{Raw: u, MX: pmx[fqn]})
+			pwm.Trend = rec.TrackPod(pwm.MX)
+			res = append(res, &pwm)
 		}
 	}
 
@@ -488,6 +508,10 @@ func readLogs(ctx context.Context, stream io.ReadCloser, out chan<- *LogItem, op
 
 	for {
 		bytes, err := r.ReadBytes('\n')
+		if err == nil && opts.IsPastRange(bytes) {
+			slog.Debug("Log stream reached range end", slogs.Container, opts.Info())
+			return streamEOF
+		}
 		if err == nil {
 			item := opts.ToLogItem(tview.EscapeBytes(bytes))
 			select {
@@ -104,5 +104,6 @@ func BenchConfigFor(benchFile, path string) config.BenchConfig {
 	}
 
 	def.C, def.N = cust.Benchmarks.Defaults.C, cust.Benchmarks.Defaults.N
+	def.QPS, def.Duration = cust.Benchmarks.Defaults.QPS, cust.Benchmarks.Defaults.Duration
 	return def
 }
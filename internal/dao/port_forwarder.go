@@ -8,6 +8,8 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
@@ -37,6 +39,12 @@ type PortForwarder struct {
 	path                string
 	tunnel              port.PortTunnel
 	age                 time.Time
+	follow              *client.GVR
+	target              string
+	stopped             atomic.Bool
+	reconnects          atomic.Int32
+	bytesIn, bytesOut   atomic.Int64
+	mx                  sync.RWMutex
 }
 
 // NewPortForwarder returns a new port forward streamer.
@@ -50,11 +58,83 @@ func NewPortForwarder(f Factory) *PortForwarder {
 
 // String dumps as string.
 func (p *PortForwarder) String() string {
-	return fmt.Sprintf("%s|%s", p.path, p.tunnel)
+	return fmt.Sprintf("%s|%s", p.Path(), p.tunnel)
+}
+
+// Follow re-resolves a ready pod from the given resource when the forwarded pod goes away.
+func (p *PortForwarder) Follow(gvr *client.GVR, path string) {
+	p.follow, p.target = gvr, path
+}
+
+// IsFollowing checks if the forward follows pod replacements.
+func (p *PortForwarder) IsFollowing() bool {
+	return p.follow != nil
+}
+
+// IsStopped checks if the forward was stopped.
+func (p *PortForwarder) IsStopped() bool {
+	return p.stopped.Load()
+}
+
+// Target returns the followed resource if any.
+func (p *PortForwarder) Target() string {
+	if p.follow == nil {
+		return ""
+	}
+
+	return p.follow.R() + ":" + p.target
+}
+
+// Reconnects returns the number of reconnections.
+func (p *PortForwarder) Reconnects() int {
+	return int(p.reconnects.Load())
+}
+
+// BytesIn returns the number of bytes received from the pod.
+func (p *PortForwarder) BytesIn() int64 {
+	return p.bytesIn.Load()
+}
+
+// BytesOut returns the number of bytes sent to the pod.
+func (p *PortForwarder) BytesOut() int64 {
+	return p.bytesOut.Load()
+}
+
+// Reconnect forwards to a ready pod backing the followed resource.
+func (p *PortForwarder) Reconnect() (*portforward.PortForwarder, error) {
+	if p.follow == nil {
+		return nil, fmt.Errorf("port-forward %s is not following a resource", p.ID())
+	}
+	path, err := ReadyPodFor(p.Factory, p.follow, p.target, p.Path())
+	if err != nil {
+		return nil, err
+	}
+
+	p.mx.Lock()
+	if p.stopped.Load() {
+		p.mx.Unlock()
+		return nil, fmt.Errorf("port-forward %s was stopped", p.ID())
+	}
+	p.stopChan, p.readyChan = make(chan struct{}), make(chan struct{})
+	age, tunnel := p.age, p.tunnel
+	p.mx.Unlock()
+	fwd, err := p.Start(path, tunnel)
+	p.mx.Lock()
+	p.age = age
+	p.mx.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	p.reconnects.Add(1)
+
+	return fwd, nil
 }
 
 // Age returns the port forward age.
 func (p *PortForwarder) Age() time.Time {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
 	return p.age
 }
 
@@ -90,7 +170,15 @@ func (p *PortForwarder) LocalPort() string {
 
 // ID returns a pf id.
 func (p *PortForwarder) ID() string {
-	return PortForwardID(p.path, p.tunnel.Container, p.tunnel.PortMap())
+	return PortForwardID(p.Path(), p.tunnel.Container, p.tunnel.PortMap())
+}
+
+// Path returns the forwarded pod path.
+func (p *PortForwarder) Path() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.path
 }
 
 // Container returns the target's container.
@@ -100,7 +188,11 @@ func (p *PortForwarder) Container() string {
 
 // Stop terminates a port forward.
 func (p *PortForwarder) Stop() {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
 	p.active = false
+	p.stopped.Store(true)
 	if p.stopChan != nil {
 		close(p.stopChan)
 		p.stopChan = nil
@@ -109,7 +201,7 @@ func (p *PortForwarder) Stop() {
 
 // FQN returns the portforward unique id.
 func (p *PortForwarder) FQN() string {
-	return p.path + ":" + p.tunnel.Container
+	return p.Path() + ":" + p.tunnel.Container
 }
 
 // HasPortMapping checks if port mapping is defined for this fwd.
@@ -119,7 +211,9 @@ func (p *PortForwarder) HasPortMapping(portMap string) bool {
 
 // Start initiates a port forward session for a given pod and ports.
 func (p *PortForwarder) Start(path string, tt port.PortTunnel) (*portforward.PortForwarder, error) {
+	p.mx.Lock()
 	p.path, p.tunnel, p.age = path, tt, time.Now()
+	p.mx.Unlock()
 
 	ns, n := client.Namespaced(path)
 	auth, err := p.Client().CanI(ns, client.PodGVR, n, client.GetAccess)
@@ -207,9 +301,69 @@ func (p *PortForwarder) forwardPorts(method string, u *url.URL, addr, portMap st
 		})
 	}
 
+	dialer = &countingDialer{Dialer: dialer, in: &p.bytesIn, out: &p.bytesOut}
+
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
 	return portforward.NewOnAddresses(dialer, []string{addr}, []string{portMap}, p.stopChan, p.readyChan, p.Out, p.ErrOut)
 }
 
+// countingDialer tracks the data streams traffic of port-forward connections.
+type countingDialer struct {
+	httpstream.Dialer
+
+	in, out *atomic.Int64
+}
+
+// Dial dials a new connection.
+func (d *countingDialer) Dial(protocols ...string) (httpstream.Connection, string, error) {
+	conn, proto, err := d.Dialer.Dial(protocols...)
+	if err != nil {
+		return conn, proto, err
+	}
+
+	return &countingConn{Connection: conn, in: d.in, out: d.out}, proto, nil
+}
+
+type countingConn struct {
+	httpstream.Connection
+
+	in, out *atomic.Int64
+}
+
+// CreateStream creates a new stream.
+func (c *countingConn) CreateStream(h http.Header) (httpstream.Stream, error) {
+	s, err := c.Connection.CreateStream(h)
+	if err != nil || h.Get(v1.StreamType) != v1.StreamTypeData {
+		return s, err
+	}
+
+	return &countingStream{Stream: s, in: c.in, out: c.out}, nil
+}
+
+type countingStream struct {
+	httpstream.Stream
+
+	in, out *atomic.Int64
+}
+
+// Read reads data sent by the pod.
+func (s *countingStream) Read(bb []byte) (int, error) {
+	n, err := s.Stream.Read(bb)
+	s.in.Add(int64(n))
+
+	return n, err
+}
+
+// Write sends data to the pod.
+func (s *countingStream) Write(bb []byte) (int, error) {
+	n, err := s.Stream.Write(bb)
+	s.out.Add(int64(n))
+
+	return n, err
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
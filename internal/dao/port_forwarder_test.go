@@ -5,6 +5,8 @@ package dao
 
 import (
 	"fmt"
+	"net/http"
+	"sync/atomic"
 	"testing"
 
 	"github.com/derailed/k9s/internal/client"
@@ -16,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/httpstream"
 	"k8s.io/apimachinery/pkg/version"
 	"k8s.io/client-go/discovery/cached/disk"
 	"k8s.io/client-go/dynamic"
@@ -95,6 +98,45 @@ func TestPortForwarder_Start(t *testing.T) {
 
 // Removed separate per-scenario test functions in favor of table-driven test above.
 
+func TestPortForwarder_Follow(t *testing.T) {
+	pf := NewPortForwarder(makePortForwardFactoryWithAuth(v1.PodRunning, authConfig{}))
+	assert.False(t, pf.IsFollowing())
+	assert.Empty(t, pf.Target())
+	_, err := pf.Reconnect()
+	require.Error(t, err)
+
+	pf.Follow(client.DpGVR, "default/fred")
+	assert.True(t, pf.IsFollowing())
+	assert.Equal(t, "deployments:default/fred", pf.Target())
+
+	pf.Stop()
+	assert.True(t, pf.IsStopped())
+}
+
+func TestCountingStream(t *testing.T) {
+	var in, out atomic.Int64
+	conn := countingConn{Connection: &fakeStreamConn{}, in: &in, out: &out}
+
+	h := http.Header{}
+	h.Set(v1.StreamType, v1.StreamTypeError)
+	s, err := conn.CreateStream(h)
+	require.NoError(t, err)
+	_, err = s.Write([]byte("boom"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), out.Load())
+
+	h.Set(v1.StreamType, v1.StreamTypeData)
+	s, err = conn.CreateStream(h)
+	require.NoError(t, err)
+	_, err = s.Write([]byte("hello"))
+	require.NoError(t, err)
+	bb := make([]byte, 3)
+	_, err = s.Read(bb)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), out.Load())
+	assert.Equal(t, int64(3), in.Load())
+}
+
 // ----------------------------------------------------------------------------
 // Test Helpers
 // ----------------------------------------------------------------------------
@@ -211,3 +253,20 @@ func (c *pfConn) CanI(_ string, gvr *client.GVR, _ string, verbs []string) (bool
 
 	return false, nil
 }
+
+type fakeStreamConn struct {
+	httpstream.Connection
+}
+
+func (*fakeStreamConn) CreateStream(h http.Header) (httpstream.Stream, error) {
+	return &fakeStream{h: h}, nil
+}
+
+type fakeStream struct {
+	httpstream.Stream
+	h http.Header
+}
+
+func (*fakeStream) Read(bb []byte) (int, error)  { return len(bb), nil }
+func (*fakeStream) Write(bb []byte) (int, error) { return len(bb), nil }
+func (s *fakeStream) Headers() http.Header       { return s.h }
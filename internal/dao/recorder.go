@@ -5,17 +5,20 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"sync"
 	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/cache"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
 var MxRecorder *Recorder
@@ -24,6 +27,8 @@ const (
 	seriesCacheSize   = 600
 	seriesCacheExpiry = 3 * time.Hour
 	seriesRecordRate  = 1 * time.Minute
+	trendCacheSize    = 5_000
+	trendCacheExpiry  = 1 * time.Hour
 	nodeMetrics       = "node"
 	podMetrics        = "pod"
 )
@@ -38,11 +43,19 @@ type Point struct {
 	Value client.NodeMetrics
 }
 
+type trend struct {
+	at       time.Time
+	cpu, mem []int64
+}
+
 type Recorder struct {
-	conn   client.Connection
-	series *cache.LRUExpireCache
-	mxChan MetricsChan
-	mx     sync.RWMutex
+	conn    client.Connection
+	series  *cache.LRUExpireCache
+	trends  *cache.LRUExpireCache
+	store   *MetricsStore
+	history TimeSeries
+	mxChan  MetricsChan
+	mx      sync.RWMutex
 }
 
 func DialRecorder(c client.Connection) *Recorder {
@@ -52,6 +65,7 @@ func DialRecorder(c client.Connection) *Recorder {
 	MxRecorder = &Recorder{
 		conn:   c,
 		series: cache.NewLRUExpireCache(seriesCacheSize),
+		trends: cache.NewLRUExpireCache(trendCacheSize),
 	}
 
 	return MxRecorder
@@ -70,33 +84,185 @@ func (r *Recorder) Clear() {
 	for _, k := range kk {
 		r.series.Remove(k)
 	}
+	for _, k := range r.trends.Keys() {
+		r.trends.Remove(k)
+	}
 }
 
-func (r *Recorder) dispatchSeries(kind, ns string) {
-	if r.mxChan == nil {
+// TrackPod records a pod usage sample and returns the pod usage trend.
+func (r *Recorder) TrackPod(pmx *mv1beta1.PodMetrics) *render.Trend {
+	if pmx == nil {
+		return nil
+	}
+	var cpu, mem int64
+	for _, c := range pmx.Containers {
+		cpu += c.Usage.Cpu().MilliValue()
+		mem += c.Usage.Memory().Value()
+	}
+
+	return r.track(podMetrics+":"+client.FQN(pmx.Namespace, pmx.Name), pmx.Timestamp.Time, cpu, mem)
+}
+
+// TrackNode records a node usage sample and returns the node usage trend.
+func (r *Recorder) TrackNode(nmx *mv1beta1.NodeMetrics) *render.Trend {
+	if nmx == nil {
+		return nil
+	}
+
+	return r.track(nodeMetrics+":"+nmx.Name, nmx.Timestamp.Time, nmx.Usage.Cpu().MilliValue(), nmx.Usage.Memory().Value())
+}
+
+func (r *Recorder) track(key string, at time.Time, cpu, mem int64) *render.Trend {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	t, ok := r.trends.Get(key)
+	tr, cool := t.(*trend)
+	if !ok || !cool {
+		tr = new(trend)
+	}
+	// Metrics are cached upstream so only record fresh samples.
+	if len(tr.cpu) == 0 || at.After(tr.at) {
+		tr.at = at
+		tr.cpu = appendSample(tr.cpu, cpu)
+		tr.mem = appendSample(tr.mem, mem)
+	}
+	r.trends.Add(key, tr, trendCacheExpiry)
+
+	return &render.Trend{
+		CPU: slices.Clone(tr.cpu),
+		MEM: slices.Clone(tr.mem),
+	}
+}
+
+func appendSample(vv []int64, v int64) []int64 {
+	vv = append(vv, v)
+	if len(vv) > render.SparklineWidth {
+		vv = vv[len(vv)-render.SparklineWidth:]
+	}
+
+	return vv
+}
+
+// SetStore persists recorded metrics to the given store. Switching stores ie on context switch
+// resets the in-memory series and loads the store history.
+func (r *Recorder) SetStore(s *MetricsStore) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if r.store == s || (r.store != nil && s != nil && r.store.Path() == s.Path()) {
 		return
 	}
+	for _, k := range r.series.Keys() {
+		r.series.Remove(k)
+	}
+	r.store, r.history = s, nil
+	if s == nil {
+		return
+	}
+	now := time.Now()
+	if err := s.Compact(now); err != nil {
+		slog.Error("Metrics history compaction failed",
+			slogs.Path, s.Path(),
+			slogs.Error, err,
+		)
+	}
+	ss, err := s.Load(now.Add(-s.Retention()))
+	if err != nil {
+		slog.Error("Metrics history load failed",
+			slogs.Path, s.Path(),
+			slogs.Error, err,
+		)
+		return
+	}
+	r.history = ss
+}
+
+// Series returns the recorded metrics for a given namespace. Persisted metrics are
+// returned when a store is set, otherwise the last hour of in-memory metrics.
+func (r *Recorder) Series(ns string) TimeSeries {
+	kind := podMetrics
+	if client.IsAllNamespaces(ns) {
+		kind = nodeMetrics
+	}
+
+	r.mx.RLock()
+	if r.store != nil {
+		defer r.mx.RUnlock()
+		return filterSeries(r.history, kind, ns)
+	}
+	r.mx.RUnlock()
+
 	kk := r.series.Keys()
 	hour := time.Now().Add(-1 * time.Hour)
-	ts := make(TimeSeries, 0, len(kk))
+	ss := make(TimeSeries, 0, len(kk))
 	for _, k := range kk {
 		if v, ok := r.series.Get(k); ok {
-			if pt, cool := v.(Point); cool {
-				if pt.Tags["type"] != kind || pt.Time.Sub(hour) < 0 {
-					continue
-				}
-				switch kind {
-				case nodeMetrics:
-					ts = append(ts, pt)
-				case podMetrics:
-					if client.IsAllNamespaces(ns) || pt.Tags["namespace"] == ns {
-						ts = append(ts, pt)
-					}
-				}
+			if pt, cool := v.(Point); cool && !pt.Time.Before(hour) {
+				ss = append(ss, pt)
 			}
 		}
 	}
-	if len(ts) > 0 {
+
+	return filterSeries(ss, kind, ns)
+}
+
+// trimSeries drops the points recorded before a given time.
+func trimSeries(ss TimeSeries, since time.Time) TimeSeries {
+	idx := slices.IndexFunc(ss, func(pt Point) bool {
+		return !pt.Time.Before(since)
+	})
+	if idx < 0 {
+		return ss[:0]
+	}
+
+	return ss[idx:]
+}
+
+func filterSeries(ss TimeSeries, kind, ns string) TimeSeries {
+	ts := make(TimeSeries, 0, len(ss))
+	for _, pt := range ss {
+		if pt.Tags["type"] != kind {
+			continue
+		}
+		switch kind {
+		case nodeMetrics:
+			ts = append(ts, pt)
+		case podMetrics:
+			if client.IsAllNamespaces(ns) || pt.Tags["namespace"] == ns {
+				ts = append(ts, pt)
+			}
+		}
+	}
+
+	return ts
+}
+
+func (r *Recorder) add(pt Point) {
+	r.series.Add(pt.Time, pt, seriesCacheExpiry)
+
+	r.mx.Lock()
+	store := r.store
+	if store != nil {
+		r.history = append(trimSeries(r.history, pt.Time.Add(-store.Retention())), pt)
+	}
+	r.mx.Unlock()
+	if store == nil {
+		return
+	}
+	if err := store.Append(pt); err != nil {
+		slog.Error("Metrics history append failed",
+			slogs.Path, store.Path(),
+			slogs.Error, err,
+		)
+	}
+}
+
+func (r *Recorder) dispatchSeries(ns string) {
+	if r.mxChan == nil {
+		return
+	}
+	if ts := r.Series(ns); len(ts) > 0 {
 		r.mxChan <- ts
 	}
 }
@@ -125,7 +291,7 @@ func (r *Recorder) Watch(ctx context.Context, ns string) MetricsChan {
 				slog.Error("Record node metrics failed", slogs.Error, err)
 			}
 		}
-		r.dispatchSeries(kind, ns)
+		r.dispatchSeries(ns)
 		<-ctx.Done()
 		r.mx.Lock()
 		if r.mxChan != nil {
@@ -197,7 +363,7 @@ func (r *Recorder) recordClusterMetrics(ctx context.Context, nn *v1.NodeList) {
 		},
 	}
 	if len(nn.Items) > 0 {
-		r.series.Add(pt.Time, pt, seriesCacheExpiry)
+		r.add(pt)
 	}
 	r.mx.Lock()
 	defer r.mx.Unlock()
@@ -261,7 +427,7 @@ func (r *Recorder) recordPodsMetrics(ctx context.Context, ns string) error {
 	if len(pp.Items) > 0 {
 		pt.Value.AllocatableCPU = pt.Value.CurrentCPU
 		pt.Value.AllocatableMEM = pt.Value.CurrentMEM
-		r.series.Add(pt.Time, pt, seriesCacheExpiry)
+		r.add(pt)
 		r.mx.Lock()
 		defer r.mx.Unlock()
 		if r.mxChan != nil {
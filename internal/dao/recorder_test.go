@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func makeTrendPodMX(at time.Time, cpu, mem string) *mv1beta1.PodMetrics {
+	return &mv1beta1.PodMetrics{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "p1"},
+		Timestamp:  metav1.Time{Time: at},
+		Containers: []mv1beta1.ContainerMetrics{
+			{
+				Name: "c1",
+				Usage: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse(cpu),
+					v1.ResourceMemory: resource.MustParse(mem),
+				},
+			},
+		},
+	}
+}
+
+func TestRecorderTrackPod(t *testing.T) {
+	dao.ResetRecorder(nil)
+	r := dao.DialRecorder(nil)
+	t.Cleanup(func() { dao.ResetRecorder(nil) })
+
+	assert.Nil(t, r.TrackPod(nil))
+
+	at := time.Now()
+	r.TrackPod(makeTrendPodMX(at, "10m", "1Mi"))
+	// Same sample timestamp ie cached metrics are not recorded twice.
+	r.TrackPod(makeTrendPodMX(at, "10m", "1Mi"))
+	tr := r.TrackPod(makeTrendPodMX(at.Add(time.Minute), "20m", "2Mi"))
+
+	assert.Equal(t, []int64{10, 20}, tr.CPU)
+	assert.Equal(t, []int64{1 << 20, 2 << 20}, tr.MEM)
+
+	for i := range 20 {
+		tr = r.TrackPod(makeTrendPodMX(at.Add(time.Duration(i+2)*time.Minute), "30m", "3Mi"))
+	}
+	assert.Len(t, tr.CPU, 10)
+
+	r.Clear()
+	tr = r.TrackPod(makeTrendPodMX(at, "10m", "1Mi"))
+	assert.Equal(t, []int64{10}, tr.CPU)
+}
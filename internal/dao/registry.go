@@ -205,6 +205,32 @@ func loadK9s(m ResourceMetas) {
 		SingularName: "dir",
 		Categories:   []string{k9sCat},
 	}
+	m[client.LpGVR] = &metav1.APIResource{
+		Name:         "logpatterns",
+		Kind:         "LogPattern",
+		SingularName: "logpattern",
+		Categories:   []string{k9sCat},
+	}
+	m[client.PfpGVR] = &metav1.APIResource{
+		Name:         "pf-profiles",
+		Kind:         "PFProfile",
+		SingularName: "pf-profile",
+		Categories:   []string{k9sCat},
+	}
+	m[client.AlGVR] = &metav1.APIResource{
+		Name:         "alerts",
+		Kind:         "Alert",
+		SingularName: "alert",
+		ShortNames:   []string{"al"},
+		Categories:   []string{k9sCat},
+	}
+	m[client.VdbGVR] = &metav1.APIResource{
+		Name:         "vuldbs",
+		Kind:         "VulDB",
+		SingularName: "vuldb",
+		ShortNames:   []string{"vdb"},
+		Categories:   []string{k9sCat},
+	}
 	m[client.XGVR] = &metav1.APIResource{
 		Name:         "xrays",
 		Kind:         "XRays",
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/vul"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+var _ Accessor = (*VulDB)(nil)
+
+// VulDB represents the vulnerability database status.
+type VulDB struct {
+	NonResource
+}
+
+// NewVulDB returns a new vulnerability database accessor.
+func NewVulDB(f Factory) *VulDB {
+	var v VulDB
+	v.Init(f, client.VdbGVR)
+
+	return &v
+}
+
+// List returns the vulnerability database status properties.
+func (*VulDB) List(context.Context, string) ([]runtime.Object, error) {
+	st := vul.DBStatus{State: vul.DBDisabled}
+	if vul.ImgScanner != nil {
+		st = vul.ImgScanner.DBStatus()
+	}
+
+	return vulDBProps(st, time.Now()), nil
+}
+
+// Get fetch a resource.
+func (*VulDB) Get(context.Context, string) (runtime.Object, error) {
+	return nil, errors.New("nyi")
+}
+
+func vulDBProps(st vul.DBStatus, now time.Time) []runtime.Object {
+	pp := []render.VulDBRes{
+		{Name: render.VulDBState, Value: string(st.State)},
+		{Name: "Schema", Value: st.SchemaVersion},
+		{Name: "Built", Value: toTimestamp(st.Built)},
+		{Name: "Age", Value: toDuration(st.Age(now))},
+		{Name: "Max Age", Value: toDuration(st.MaxAge)},
+		{Name: "Source", Value: st.From},
+		{Name: "Path", Value: st.Path},
+		{Name: "Archive", Value: st.Archive},
+		{Name: "Update URL", Value: st.UpdateURL},
+		{Name: "Auto Update", Value: strconv.FormatBool(st.AutoUpdate)},
+		{Name: "Last Check", Value: toTimestamp(st.Checked)},
+		{Name: "Updated", Value: strconv.FormatBool(st.Updated)},
+		{Name: render.VulDBUpdateError, Value: errToStr(st.UpdateErr)},
+		{Name: render.VulDBError, Value: errToStr(st.Err)},
+	}
+	oo := make([]runtime.Object, 0, len(pp))
+	for _, p := range pp {
+		oo = append(oo, p)
+	}
+
+	return oo
+}
+
+func toTimestamp(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+func toDuration(d time.Duration) string {
+	if d <= 0 {
+		return ""
+	}
+
+	return duration.HumanDuration(d)
+}
+
+func errToStr(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	return err.Error()
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/vul"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVulDBProps(t *testing.T) {
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	st := vul.DBStatus{
+		State:         vul.DBStale,
+		SchemaVersion: "v6.0.2",
+		Built:         now.Add(-72 * time.Hour),
+		MaxAge:        48 * time.Hour,
+		Archive:       "/opt/grype/db.tar.zst",
+		UpdateErr:     errors.New("update failed: no route to host"),
+	}
+
+	oo := vulDBProps(st, now)
+	pp := make(map[string]string, len(oo))
+	for _, o := range oo {
+		p, ok := o.(render.VulDBRes)
+		assert.True(t, ok)
+		pp[p.Name] = p.Value
+	}
+
+	assert.Equal(t, "STALE", pp[render.VulDBState])
+	assert.Equal(t, "v6.0.2", pp["Schema"])
+	assert.Equal(t, "2024-06-07T12:00:00Z", pp["Built"])
+	assert.Equal(t, "3d", pp["Age"])
+	assert.Equal(t, "2d", pp["Max Age"])
+	assert.Equal(t, "/opt/grype/db.tar.zst", pp["Archive"])
+	assert.Equal(t, "false", pp["Auto Update"])
+	assert.Empty(t, pp["Last Check"])
+	assert.Equal(t, "update failed: no route to host", pp[render.VulDBUpdateError])
+	assert.Empty(t, pp[render.VulDBError])
+}
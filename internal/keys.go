@@ -36,4 +36,8 @@ const (
 	KeyWait          ContextKey = "wait"
 	KeyPodCounting   ContextKey = "podCounting"
 	KeyEnableImgScan ContextKey = "vulScan"
+	KeyLogItems      ContextKey = "logItems"
+	KeyAlertCenter   ContextKey = "alertCenter"
+	KeyPFProfiles    ContextKey = "pfProfiles"
+	KeyPFAddress     ContextKey = "pfAddress"
 )
@@ -0,0 +1,369 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/slogs"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// ContextCol represents the fleet context column name.
+	ContextCol = "CONTEXT"
+
+	fleetSep = "@"
+)
+
+// FleetFQN returns a fully qualified resource name across contexts.
+func FleetFQN(ct, fqn string) string {
+	return ct + fleetSep + fqn
+}
+
+// ParseFleetFQN splits a fleet resource name into its context and resource path.
+func ParseFleetFQN(path string) (ct, fqn string, ok bool) {
+	idx := strings.LastIndex(path, fleetSep)
+	if idx <= 0 {
+		return "", path, false
+	}
+
+	return path[:idx], path[idx+1:], true
+}
+
+// FleetTable represents a table model aggregated across several contexts.
+type FleetTable struct {
+	gvr         *client.GVR
+	contexts    []string
+	factories   map[string]dao.Factory
+	tables      map[string]*Table
+	data        *model1.TableData
+	listeners   []TableListener
+	inUpdate    int32
+	refreshRate time.Duration
+	mx          sync.RWMutex
+}
+
+// NewFleetTable returns a new fleet table model given per context factories.
+func NewFleetTable(gvr *client.GVR, factories map[string]dao.Factory) *FleetTable {
+	t := FleetTable{
+		gvr:         gvr,
+		factories:   factories,
+		tables:      make(map[string]*Table, len(factories)),
+		data:        model1.NewTableData(gvr),
+		refreshRate: 2 * time.Second,
+	}
+	for ct := range factories {
+		t.contexts = append(t.contexts, ct)
+		t.tables[ct] = NewTable(gvr)
+	}
+	slices.Sort(t.contexts)
+
+	return &t
+}
+
+// Contexts returns the aggregated contexts.
+func (t *FleetTable) Contexts() []string {
+	return t.contexts
+}
+
+// SetViewSetting sets custom view settings for all contexts.
+func (t *FleetTable) SetViewSetting(ctx context.Context, vs *config.ViewSetting) {
+	for _, ct := range t.contexts {
+		cctx := ctx
+		if ctx != context.Background() {
+			cctx = t.contextFor(ctx, ct)
+		}
+		t.tables[ct].SetViewSetting(cctx, vs)
+	}
+	if ctx != context.Background() {
+		t.merge(nil)
+	}
+}
+
+// SetLabelSelector sets the labels selector.
+func (t *FleetTable) SetLabelSelector(sel labels.Selector) {
+	for _, ct := range t.contexts {
+		t.tables[ct].SetLabelSelector(sel)
+	}
+}
+
+// GetLabelSelector returns the labels selector.
+func (t *FleetTable) GetLabelSelector() labels.Selector {
+	if len(t.contexts) == 0 {
+		return labels.Everything()
+	}
+
+	return t.tables[t.contexts[0]].GetLabelSelector()
+}
+
+// SetInstance sets a single entry table.
+func (t *FleetTable) SetInstance(path string) {
+	if path == "" {
+		return
+	}
+	if ct, fqn, ok := ParseFleetFQN(path); ok {
+		if tt, ok := t.tables[ct]; ok {
+			tt.SetInstance(fqn)
+		}
+	}
+}
+
+// AddListener adds a new model listener.
+func (t *FleetTable) AddListener(l TableListener) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.listeners = append(t.listeners, l)
+}
+
+// RemoveListener delete a listener from the list.
+func (t *FleetTable) RemoveListener(l TableListener) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.listeners = slices.DeleteFunc(t.listeners, func(lis TableListener) bool {
+		return lis == l
+	})
+}
+
+// Watch initiates model updates.
+func (t *FleetTable) Watch(ctx context.Context) error {
+	if err := t.refresh(ctx); err != nil {
+		return err
+	}
+	go t.updater(ctx)
+
+	return nil
+}
+
+// Refresh updates the table content.
+func (t *FleetTable) Refresh(ctx context.Context) error {
+	return t.refresh(ctx)
+}
+
+// Get returns a resource instance if found, else an error.
+func (t *FleetTable) Get(ctx context.Context, path string) (runtime.Object, error) {
+	ctx, tt, fqn, err := t.tableFor(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return tt.Get(ctx, fqn)
+}
+
+// Delete deletes a resource.
+func (t *FleetTable) Delete(ctx context.Context, path string, propagation *metav1.DeletionPropagation, grace dao.Grace) error {
+	ctx, tt, fqn, err := t.tableFor(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	return tt.Delete(ctx, fqn, propagation, grace)
+}
+
+// GetNamespace returns the model namespace.
+func (t *FleetTable) GetNamespace() string {
+	return t.data.GetNamespace()
+}
+
+// SetNamespace sets up model namespace.
+func (t *FleetTable) SetNamespace(ns string) {
+	t.mx.Lock()
+	t.data.Reset(ns)
+	t.mx.Unlock()
+	for _, ct := range t.contexts {
+		t.tables[ct].SetNamespace(ns)
+	}
+}
+
+// InNamespace checks if current namespace matches desired namespace.
+func (t *FleetTable) InNamespace(ns string) bool {
+	return t.data.GetNamespace() == ns && !t.data.Empty()
+}
+
+// SetRefreshRate sets model refresh duration.
+func (t *FleetTable) SetRefreshRate(d time.Duration) {
+	t.refreshRate = d
+}
+
+// ClusterWide checks if resource is scope for all namespaces.
+func (t *FleetTable) ClusterWide() bool {
+	return client.IsClusterWide(t.data.GetNamespace())
+}
+
+// Empty returns true if no model data.
+func (t *FleetTable) Empty() bool {
+	return t.data.Empty()
+}
+
+// RowCount returns the row count.
+func (t *FleetTable) RowCount() int {
+	return t.data.RowCount()
+}
+
+// Peek returns model data.
+func (t *FleetTable) Peek() *model1.TableData {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return t.data.Clone()
+}
+
+func (t *FleetTable) tableFor(ctx context.Context, path string) (context.Context, *Table, string, error) {
+	ct, fqn, ok := ParseFleetFQN(path)
+	if !ok {
+		return ctx, nil, "", fmt.Errorf("invalid fleet resource path %q", path)
+	}
+	tt, ok := t.tables[ct]
+	if !ok {
+		return ctx, nil, "", fmt.Errorf("no context %q in fleet", ct)
+	}
+
+	return t.contextFor(ctx, ct), tt, fqn, nil
+}
+
+func (t *FleetTable) contextFor(ctx context.Context, ct string) context.Context {
+	f := t.factories[ct]
+	ctx = context.WithValue(ctx, internal.KeyFactory, f)
+	if withMx, ok := ctx.Value(internal.KeyWithMetrics).(bool); ok && withMx {
+		ctx = context.WithValue(ctx, internal.KeyWithMetrics, f.Client().HasMetrics())
+	}
+
+	return ctx
+}
+
+func (t *FleetTable) updater(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(t.refreshRate):
+			if err := t.refresh(ctx); err != nil {
+				slog.Warn("Fleet reconciler exited", slogs.Error, err)
+				t.fireTableLoadFailed(err)
+				return
+			}
+		}
+	}
+}
+
+func (t *FleetTable) refresh(ctx context.Context) error {
+	if !atomic.CompareAndSwapInt32(&t.inUpdate, 0, 1) {
+		slog.Debug("Dropping fleet update...")
+		return nil
+	}
+	defer atomic.StoreInt32(&t.inUpdate, 0)
+
+	if err := t.reconcile(ctx); err != nil {
+		return err
+	}
+	data := t.Peek()
+	if data.RowCount() == 0 {
+		t.fireNoData(data)
+	} else {
+		t.fireTableChanged(data)
+	}
+
+	return nil
+}
+
+func (t *FleetTable) reconcile(ctx context.Context) error {
+	var (
+		errs   error
+		failed = make(map[string]struct{})
+	)
+	for _, ct := range t.contexts {
+		if err := t.tables[ct].reconcile(t.contextFor(ctx, ct)); err != nil {
+			slog.Warn("Fleet context reconcile failed",
+				slogs.Context, ct,
+				slogs.GVR, t.gvr,
+				slogs.Error, err,
+			)
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", ct, err))
+			failed[ct] = struct{}{}
+		}
+	}
+	if len(failed) == len(t.contexts) && errs != nil {
+		return errs
+	}
+	t.merge(failed)
+
+	return nil
+}
+
+// merge aggregates all contexts tables data, skipping failed contexts.
+func (t *FleetTable) merge(failed map[string]struct{}) {
+	var (
+		header model1.Header
+		rows   model1.Rows
+	)
+	for _, ct := range t.contexts {
+		if _, ok := failed[ct]; ok {
+			continue
+		}
+		data := t.tables[ct].Peek()
+		if len(header) == 0 {
+			header = append(model1.Header{{Name: ContextCol}}, data.Header()...)
+		}
+		data.RowsRange(func(_ int, re model1.RowEvent) bool {
+			row := model1.Row{
+				ID:     FleetFQN(ct, re.Row.ID),
+				Fields: append(model1.Fields{ct}, re.Row.Fields...),
+			}
+			rows = append(rows, row)
+			return true
+		})
+	}
+
+	t.mx.Lock()
+	defer t.mx.Unlock()
+	t.data.Update(rows)
+	t.data.SetHeader(t.data.GetNamespace(), header)
+}
+
+func (t *FleetTable) fireTableChanged(data *model1.TableData) {
+	t.mx.RLock()
+	ll := t.listeners
+	t.mx.RUnlock()
+
+	for _, l := range ll {
+		l.TableDataChanged(data)
+	}
+}
+
+func (t *FleetTable) fireNoData(data *model1.TableData) {
+	t.mx.RLock()
+	ll := t.listeners
+	t.mx.RUnlock()
+
+	for _, l := range ll {
+		l.TableNoData(data)
+	}
+}
+
+func (t *FleetTable) fireTableLoadFailed(err error) {
+	t.mx.RLock()
+	ll := t.listeners
+	t.mx.RUnlock()
+
+	for _, l := range ll {
+		l.TableLoadFailed(err)
+	}
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestFleetTableRefresh(t *testing.T) {
+	f1, f2 := makeTableFactory(), makeTableFactory()
+	f1.rows = []runtime.Object{mustLoad("p1")}
+	f2.rows = []runtime.Object{mustLoad("p1")}
+	ta := model.NewFleetTable(client.PodGVR, map[string]dao.Factory{
+		"prod":    f2,
+		"staging": f1,
+	})
+	ta.SetNamespace(client.NamespaceAll)
+	l := tableListener{}
+	ta.AddListener(&l)
+
+	ctx := context.WithValue(context.Background(), internal.KeyFields, "")
+	require.NoError(t, ta.Refresh(ctx))
+	data := ta.Peek()
+	assert.Equal(t, []string{"prod", "staging"}, ta.Contexts())
+	assert.Equal(t, 31, data.HeaderCount())
+	assert.Equal(t, model.ContextCol, data.Header()[0].Name)
+	assert.Equal(t, 2, data.RowCount())
+	re, ok := data.RowAt(0)
+	require.True(t, ok)
+	assert.Equal(t, model.FleetFQN("prod", "default/nginx-7fb78fb6d8-2w75j"), re.Row.ID)
+	assert.Equal(t, "prod", re.Row.Fields[0])
+	assert.Equal(t, 1, l.count)
+}
+
+func TestParseFleetFQN(t *testing.T) {
+	uu := map[string]struct {
+		path    string
+		ct, fqn string
+		ok      bool
+	}{
+		"empty": {},
+
+		"plain": {
+			path: "ns1/fred",
+			fqn:  "ns1/fred",
+		},
+
+		"fleet": {
+			path: model.FleetFQN("prod", "ns1/fred"),
+			ct:   "prod",
+			fqn:  "ns1/fred",
+			ok:   true,
+		},
+
+		"fleet-ctx-at": {
+			path: model.FleetFQN("admin@prod", "fred"),
+			ct:   "admin@prod",
+			fqn:  "fred",
+			ok:   true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ct, fqn, ok := model.ParseFleetFQN(u.path)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.ct, ct)
+			assert.Equal(t, u.fqn, fqn)
+		})
+	}
+}
@@ -18,6 +18,9 @@ import (
 	"github.com/derailed/k9s/internal/slogs"
 )
 
+// DefaultLogPageSize tracks the logs time span loaded when paging through older logs.
+const DefaultLogPageSize = 15 * time.Minute
+
 // LogsListener represents a log model listener.
 type LogsListener interface {
 	// LogChanged notifies the model changed.
@@ -51,6 +54,9 @@ type Log struct {
 	filter       string
 	lastSent     int
 	flushTimeout time.Duration
+	recorder     *LogRecorder
+	recorderCfg  config.LogRecorder
+	bufferSize   int
 }
 
 // NewLog returns a new model.
@@ -87,6 +93,28 @@ func (l *Log) IsHead() bool {
 	return l.logOptions.Head
 }
 
+// Lines returns the current log lines.
+func (l *Log) Lines() *dao.LogItems {
+	return l.lines
+}
+
+// SetHighlighter sets the log lines highlight rules.
+func (l *Log) SetHighlighter(h *dao.LogHighlighter) {
+	l.lines.SetHighlighter(h)
+}
+
+// ToggleStructured toggles structured logs rendering.
+func (l *Log) ToggleStructured(b bool) {
+	l.lines.SetStructured(b)
+	l.fireLogCleared()
+	l.fireLogBuffChanged(0)
+}
+
+// IsStructured returns true if structured logs rendering is on.
+func (l *Log) IsStructured() bool {
+	return l.lines.IsStructured()
+}
+
 // ToggleShowTimestamp toggles to logs timestamps.
 func (l *Log) ToggleShowTimestamp(b bool) {
 	l.logOptions.ShowTimestamp = b
@@ -96,6 +124,7 @@ func (l *Log) ToggleShowTimestamp(b bool) {
 func (l *Log) Head(ctx context.Context) {
 	l.mx.Lock()
 	l.logOptions.Head = true
+	l.logOptions.ClearRange()
 	l.mx.Unlock()
 	l.Restart(ctx)
 }
@@ -103,14 +132,126 @@ func (l *Log) Head(ctx context.Context) {
 // SetSinceSeconds sets the logs retrieval time.
 func (l *Log) SetSinceSeconds(ctx context.Context, i int64) {
 	l.logOptions.SinceSeconds, l.logOptions.Head = i, false
+	l.logOptions.ClearRange()
+	l.Restart(ctx)
+}
+
+// Range returns the logs time range if any.
+func (l *Log) Range() (time.Time, time.Time) {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	return l.logOptions.StartTime, l.logOptions.EndTime
+}
+
+// SetRange retrieves logs within a time range. A zero end time follows the logs.
+func (l *Log) SetRange(ctx context.Context, start, end time.Time) {
+	l.mx.Lock()
+	l.logOptions.SetRange(start, end)
+	l.mx.Unlock()
 	l.Restart(ctx)
 }
 
+// PageBack retrieves the logs chunk preceding the current range or the oldest loaded line.
+func (l *Log) PageBack(ctx context.Context) {
+	start, end := l.Range()
+	if start.IsZero() {
+		end = l.oldest()
+		start = end.Add(-l.pageSize())
+	} else {
+		if end.IsZero() {
+			end = time.Now()
+		}
+		start, end = start.Add(-end.Sub(start)), start
+	}
+	l.SetRange(ctx, start, end)
+}
+
+// PageForward retrieves the logs chunk following the current range.
+// Logs are followed once the range reaches the present.
+func (l *Log) PageForward(ctx context.Context) bool {
+	start, end := l.Range()
+	if start.IsZero() || end.IsZero() {
+		return false
+	}
+	start, end = end, end.Add(end.Sub(start))
+	if !end.Before(time.Now()) {
+		end = time.Time{}
+	}
+	l.SetRange(ctx, start, end)
+
+	return true
+}
+
+func (l *Log) pageSize() time.Duration {
+	if s := l.SinceSeconds(); s > 0 {
+		return time.Duration(s) * time.Second
+	}
+
+	return DefaultLogPageSize
+}
+
+// oldest returns the timestamp of the oldest loaded log line or now if none.
+func (l *Log) oldest() time.Time {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	for _, item := range l.lines.Items() {
+		if t, err := time.Parse(time.RFC3339Nano, item.GetTimestamp()); err == nil {
+			return t
+		}
+	}
+
+	return time.Now()
+}
+
 // Configure sets logger configuration.
 func (l *Log) Configure(opts config.Logger) {
 	l.logOptions.Lines = opts.TailCount
 	l.logOptions.SinceSeconds = opts.SinceSeconds
 	l.logOptions.LogBufferSize = opts.LogBufferSize
+	l.lines.SetStructured(opts.Structured)
+	l.recorderCfg = opts.Recorder
+	l.bufferSize = opts.BufferSize
+}
+
+// StartRecording continuously writes incoming log lines to the given file.
+func (l *Log) StartRecording(path string) error {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	if l.recorder != nil {
+		return fmt.Errorf("logs are already being recorded to %s", l.recorder.Path())
+	}
+	r, err := NewLogRecorder(path, l.recorderCfg)
+	if err != nil {
+		return err
+	}
+	l.recorder = r
+
+	return nil
+}
+
+// StopRecording stops recording logs and returns the recording path.
+func (l *Log) StopRecording() (string, error) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	if l.recorder == nil {
+		return "", nil
+	}
+	path, err := l.recorder.Path(), l.recorder.Close()
+	l.recorder = nil
+
+	return path, err
+}
+
+// IsRecording returns true if logs are being recorded.
+func (l *Log) IsRecording() bool {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	return l.recorder != nil
 }
 
 // GetPath returns resource path.
@@ -249,8 +390,9 @@ func (l *Log) Append(line *dao.LogItem) {
 	}
 	l.mx.Lock()
 	defer l.mx.Unlock()
+	l.record(line)
 	l.logOptions.SinceTime = line.GetTimestamp()
-	if l.lines.Len() < int(l.logOptions.Lines) {
+	if l.lines.Len() < l.maxLines() {
 		l.lines.Add(line)
 		return
 	}
@@ -261,6 +403,30 @@ func (l *Log) Append(line *dao.LogItem) {
 	}
 }
 
+func (l *Log) record(line *dao.LogItem) {
+	if l.recorder == nil {
+		return
+	}
+	if err := l.recorder.Record(line); err != nil {
+		slog.Error("Log recording failed",
+			slogs.Path, l.recorder.Path(),
+			slogs.Error, err,
+		)
+		_ = l.recorder.Close()
+		l.recorder = nil
+		l.fireLogError(fmt.Errorf("log recording stopped: %w", err))
+	}
+}
+
+// maxLines returns the number of lines to retain. Time ranges retain up to the view buffer size.
+func (l *Log) maxLines() int {
+	if l.logOptions.HasRange() && l.bufferSize > int(l.logOptions.Lines) {
+		return l.bufferSize
+	}
+
+	return int(l.logOptions.Lines)
+}
+
 // Notify fires of notifications to the listeners.
 func (l *Log) Notify() {
 	l.mx.Lock()
@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"sync"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+)
+
+const (
+	recorderFileMod = 0600
+	megaBytes       = 1024 * 1024
+	gzExt           = ".gz"
+)
+
+// LogRecorder continuously writes log items to disk with size based rotation.
+type LogRecorder struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+	compress   bool
+	file       *os.File
+	size       int64
+	mx         sync.Mutex
+}
+
+// NewLogRecorder returns a new recorder writing to the given path.
+func NewLogRecorder(path string, cfg config.LogRecorder) (*LogRecorder, error) {
+	r := LogRecorder{
+		path:       path,
+		maxSize:    int64(cfg.MaxSizeMB) * megaBytes,
+		maxBackups: cfg.MaxBackups,
+		compress:   cfg.Compress,
+	}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// Path returns the active recording file path.
+func (r *LogRecorder) Path() string {
+	return r.path
+}
+
+// Record writes a log item to disk as emitted by the container.
+func (r *LogRecorder) Record(item *dao.LogItem) error {
+	if item == nil || item.IsEmpty() {
+		return nil
+	}
+	raw := item.Raw()
+	bb := make([]byte, 0, len(raw)+len(item.Pod)+len(item.Container)+4)
+	if item.Pod != "" || item.Container != "" {
+		bb = append(bb, item.Info()...)
+		bb = append(bb, ' ')
+	}
+	bb = append(bb, raw...)
+	if bb[len(bb)-1] != '\n' {
+		bb = append(bb, '\n')
+	}
+
+	return r.Write(bb)
+}
+
+// Write writes raw bytes to the active file and rotates it if it exceeds its max size.
+func (r *LogRecorder) Write(bb []byte) error {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if r.file == nil {
+		return errors.New("log recorder is closed")
+	}
+	if r.size > 0 && r.size+int64(len(bb)) > r.maxSize {
+		if err := r.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := r.file.Write(bb)
+	r.size += int64(n)
+
+	return err
+}
+
+// Close closes the active file.
+func (r *LogRecorder) Close() error {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+
+	return err
+}
+
+func (r *LogRecorder) open() error {
+	f, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, recorderFileMod)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	r.file, r.size = f, info.Size()
+
+	return nil
+}
+
+// rotate shifts backups ie fred.log -> fred.log.1 -> fred.log.2 and reopens the active file.
+func (r *LogRecorder) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return err
+	}
+	r.file = nil
+
+	if r.maxBackups == 0 {
+		if err := os.Remove(r.path); err != nil {
+			return err
+		}
+		return r.open()
+	}
+	if err := removeIfExists(r.backupPath(r.maxBackups)); err != nil {
+		return err
+	}
+	for i := r.maxBackups - 1; i >= 1; i-- {
+		if err := renameIfExists(r.backupPath(i), r.backupPath(i+1)); err != nil {
+			return err
+		}
+	}
+	backup := fmt.Sprintf("%s.%d", r.path, 1)
+	if err := os.Rename(r.path, backup); err != nil {
+		return err
+	}
+	if r.compress {
+		if err := gzipFile(backup); err != nil {
+			return err
+		}
+	}
+
+	return r.open()
+}
+
+func (r *LogRecorder) backupPath(i int) string {
+	p := fmt.Sprintf("%s.%d", r.path, i)
+	if r.compress {
+		p += gzExt
+	}
+
+	return p
+}
+
+func removeIfExists(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func renameIfExists(from, to string) error {
+	if err := os.Rename(from, to); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func gzipFile(path string) error {
+	in, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = in.Close() }()
+
+	out, err := os.OpenFile(path+gzExt, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, recorderFileMod)
+	if err != nil {
+		return err
+	}
+	gz := gzip.NewWriter(out)
+	if _, err := io.Copy(gz, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		_ = out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+
+	return os.Remove(path)
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model_test
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogRecorderRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fred.log")
+	r, err := model.NewLogRecorder(path, config.NewLogRecorder())
+	require.NoError(t, err)
+
+	item := dao.NewLogItemFromString("2018-12-14T10:36:43.326972-07:00 Testing 1,2,3...")
+	item.Pod, item.Container = "fred", "c1"
+	require.NoError(t, r.Record(item))
+	require.NoError(t, r.Record(dao.NewLogItemFromString("blee\n")))
+	require.NoError(t, r.Record(dao.NewLogItemFromString("")))
+	require.NoError(t, r.Close())
+
+	bb, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "fred::c1 2018-12-14T10:36:43.326972-07:00 Testing 1,2,3...\nblee\n", string(bb))
+	assert.Error(t, r.Write([]byte("zorg")))
+}
+
+func TestLogRecorderRecordUnescaped(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fred.log")
+	r, err := model.NewLogRecorder(path, config.NewLogRecorder())
+	require.NoError(t, err)
+
+	require.NoError(t, r.Record(dao.NewLogItem(tview.EscapeBytes([]byte("[INFO] blee [x]\n")))))
+	require.NoError(t, r.Close())
+
+	bb, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "[INFO] blee [x]\n", string(bb))
+}
+
+func TestLogRecorderRotate(t *testing.T) {
+	uu := map[string]struct {
+		compress bool
+		backups  []string
+	}{
+		"plain": {
+			backups: []string{"fred.log", "fred.log.1", "fred.log.2"},
+		},
+		"compress": {
+			compress: true,
+			backups:  []string{"fred.log", "fred.log.1.gz", "fred.log.2.gz"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "fred.log")
+			r, err := model.NewLogRecorder(path, config.LogRecorder{MaxSizeMB: 1, MaxBackups: 2, Compress: u.compress})
+			require.NoError(t, err)
+
+			line := make([]byte, 400*1024)
+			for i := range line {
+				line[i] = 'a' + byte(i%26)
+			}
+			// 10 lines of 400KiB spanning 5 files with 2 backups retained.
+			for range 10 {
+				require.NoError(t, r.Write(line))
+			}
+			require.NoError(t, r.Close())
+
+			ee, err := os.ReadDir(dir)
+			require.NoError(t, err)
+			ff := make([]string, 0, len(ee))
+			for _, e := range ee {
+				ff = append(ff, e.Name())
+			}
+			assert.Equal(t, u.backups, ff)
+
+			if u.compress {
+				f, err := os.Open(filepath.Join(dir, "fred.log.1.gz"))
+				require.NoError(t, err)
+				defer f.Close()
+				gz, err := gzip.NewReader(f)
+				require.NoError(t, err)
+				bb, err := io.ReadAll(gz)
+				require.NoError(t, err)
+				assert.Len(t, bb, 2*len(line))
+			}
+		})
+	}
+}
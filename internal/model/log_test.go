@@ -239,6 +239,39 @@ func TestToggleAllContainers(t *testing.T) {
 	assert.Equal(t, "blee", m.GetContainer())
 }
 
+func TestLogPaging(t *testing.T) {
+	m := model.NewLog(client.NewGVR(""), makeLogOpts(10), 10*time.Millisecond)
+	m.Init(makeFactory())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	start := time.Date(2025, 1, 1, 2, 10, 0, 0, time.UTC)
+	m.SetRange(ctx, start, start.Add(15*time.Minute))
+	s, e := m.Range()
+	assert.Equal(t, start, s)
+	assert.Equal(t, start.Add(15*time.Minute), e)
+
+	m.PageBack(ctx)
+	s, e = m.Range()
+	assert.Equal(t, start.Add(-15*time.Minute), s)
+	assert.Equal(t, start, e)
+
+	assert.True(t, m.PageForward(ctx))
+	s, e = m.Range()
+	assert.Equal(t, start, s)
+	assert.Equal(t, start.Add(15*time.Minute), e)
+
+	m.SetRange(ctx, time.Now().Add(-10*time.Minute), time.Now().Add(-2*time.Minute))
+	assert.True(t, m.PageForward(ctx))
+	_, e = m.Range()
+	assert.True(t, e.IsZero())
+	assert.False(t, m.PageForward(ctx))
+
+	m.SetSinceSeconds(ctx, 60)
+	s, _ = m.Range()
+	assert.True(t, s.IsZero())
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -28,6 +28,8 @@ type Pulse struct {
 	namespace string
 	listeners []PulseListener
 	health    *PulseHealth
+	store     *dao.MetricsStore
+	recorder  *dao.Recorder
 }
 
 // NewPulse returns a new pulse.
@@ -50,11 +52,27 @@ func (p *Pulse) Watch(ctx context.Context) (HealthChan, dao.MetricsChan, error)
 	}
 
 	healthChan := p.health.Watch(ctx, p.namespace)
-	metricsChan := dao.DialRecorder(f.Client()).Watch(ctx, p.namespace)
+	p.recorder = dao.DialRecorder(f.Client())
+	p.recorder.SetStore(p.store)
+	metricsChan := p.recorder.Watch(ctx, p.namespace)
 
 	return healthChan, metricsChan, nil
 }
 
+// SetMetricsStore persists metrics history to the given store.
+func (p *Pulse) SetMetricsStore(s *dao.MetricsStore) {
+	p.store = s
+}
+
+// Series returns the recorded metrics history.
+func (p *Pulse) Series() dao.TimeSeries {
+	if p.recorder == nil {
+		return nil
+	}
+
+	return p.recorder.Series(p.namespace)
+}
+
 // Refresh update the model now.
 func (*Pulse) Refresh(context.Context) {}
 
@@ -27,6 +27,18 @@ var Registry = map[*client.GVR]ResourceMeta{
 		DAO:      new(dao.Dir),
 		Renderer: new(render.Dir),
 	},
+	client.LpGVR: {
+		DAO:      new(dao.LogPattern),
+		Renderer: new(render.LogPattern),
+	},
+	client.AlGVR: {
+		DAO:      new(dao.Alert),
+		Renderer: new(render.Alert),
+	},
+	client.VdbGVR: {
+		DAO:      new(dao.VulDB),
+		Renderer: new(render.VulDB),
+	},
 	client.PuGVR: {
 		DAO: new(dao.Pulse),
 	},
@@ -75,6 +87,10 @@ var Registry = map[*client.GVR]ResourceMeta{
 		DAO:      new(dao.PortForward),
 		Renderer: new(render.PortForward),
 	},
+	client.PfpGVR: {
+		DAO:      new(dao.PFProfile),
+		Renderer: new(render.PFProfile),
+	},
 	client.BeGVR: {
 		DAO:      new(dao.Benchmark),
 		Renderer: new(render.Benchmark),
@@ -126,7 +142,8 @@ var Registry = map[*client.GVR]ResourceMeta{
 		Renderer: new(render.PersistentVolume),
 	},
 	client.PvcGVR: {
-		Renderer: new(render.PersistentVolumeClaim),
+		Renderer:     new(render.PersistentVolumeClaim),
+		TreeRenderer: new(xray.PersistentVolumeClaim),
 	},
 	client.EvGVR: {
 		DAO:      new(dao.Table),
@@ -156,7 +173,22 @@ var Registry = map[*client.GVR]ResourceMeta{
 
 	// Extensions...
 	client.NpGVR: {
-		Renderer: &render.NetworkPolicy{},
+		Renderer:     &render.NetworkPolicy{},
+		TreeRenderer: new(xray.NetworkPolicy),
+	},
+	client.IngGVR: {
+		DAO:          new(dao.Table),
+		Renderer:     new(render.Table),
+		TreeDAO:      new(dao.Resource),
+		TreeRenderer: new(xray.Ingress),
+	},
+
+	// Autoscaling...
+	client.HpaGVR: {
+		DAO:          new(dao.Table),
+		Renderer:     new(render.Table),
+		TreeDAO:      new(dao.Resource),
+		TreeRenderer: new(xray.HorizontalPodAutoscaler),
 	},
 
 	// Batch...
@@ -182,7 +214,8 @@ var Registry = map[*client.GVR]ResourceMeta{
 
 	// Policy...
 	client.PdbGVR: {
-		Renderer: &render.PodDisruptionBudget{},
+		Renderer:     &render.PodDisruptionBudget{},
+		TreeRenderer: new(xray.PodDisruptionBudget),
 	},
 
 	// RBAC...
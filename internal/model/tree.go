@@ -243,10 +243,17 @@ func (t *Tree) resourceMeta() ResourceMeta {
 			DAO:      &dao.Table{},
 			Renderer: &render.Table{},
 		}
+		// Custom resources are walked via their owner references.
+		if m, err := dao.MetaAccess.MetaFor(t.gvr); err == nil && dao.IsCRD(m) {
+			meta.TreeDAO, meta.TreeRenderer = &dao.Resource{}, new(xray.Owner)
+		}
 	}
 	if meta.DAO == nil {
 		meta.DAO = &dao.Resource{}
 	}
+	if meta.TreeDAO != nil {
+		meta.DAO = meta.TreeDAO
+	}
 
 	return meta
 }
@@ -148,5 +148,6 @@ type TreeRenderer interface {
 type ResourceMeta struct {
 	DAO          dao.Accessor
 	Renderer     model1.Renderer
+	TreeDAO      dao.Accessor
 	TreeRenderer TreeRenderer
 }
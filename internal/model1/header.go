@@ -22,6 +22,8 @@ type Attrs struct {
 	Show      bool
 	MX        bool
 	MXC, MXM  bool
+	TrendCPU  bool
+	TrendMEM  bool
 	Time      bool
 	Capacity  bool
 	VS        bool
@@ -32,6 +34,8 @@ func (a Attrs) Merge(b Attrs) Attrs {
 	a.MX = b.MX
 	a.MXC = b.MXC
 	a.MXM = b.MXM
+	a.TrendCPU = b.TrendCPU
+	a.TrendMEM = b.TrendMEM
 	a.Decorator = b.Decorator
 	a.VS = b.VS
 
@@ -6,10 +6,13 @@ package perf
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"log/slog"
+	"math"
 	"net/http"
+	"net/http/httptrace"
 	"os"
 	"path/filepath"
 	"strings"
@@ -26,22 +29,31 @@ import (
 const (
 	// BOZO!! Revisit bench and when we should timeout.
 	benchTimeout = 2 * time.Minute
+	benchGrace   = 30 * time.Second
 	benchFmat    = "%s_%s_%d.txt"
 	k9sUA        = "k9s/"
+
+	// maxStageRequests caps the number of requests of unthrottled duration stages.
+	maxStageRequests = 100_000
 )
 
 // Benchmark puts a workload under load.
 type Benchmark struct {
 	canceled bool
 	config   *config.BenchConfig
-	worker   *requester.Work
+	request  *http.Request
+	body     []byte
+	stages   []config.BenchStage
+	stopFn   func()
 	cancelFn context.CancelFunc
+	progress progress
+	done     chan struct{}
 	mx       sync.RWMutex
 }
 
 // NewBenchmark returns a new benchmark.
 func NewBenchmark(base, version string, cfg *config.BenchConfig) (*Benchmark, error) {
-	b := Benchmark{config: cfg}
+	b := Benchmark{config: cfg, done: make(chan struct{})}
 	if err := b.init(base, version); err != nil {
 		return nil, err
 	}
@@ -49,8 +61,17 @@ func NewBenchmark(base, version string, cfg *config.BenchConfig) (*Benchmark, er
 }
 
 func (b *Benchmark) init(base, version string) error {
+	stages, err := b.config.LoadStages()
+	if err != nil {
+		return err
+	}
+	b.stages = stages
+	if b.body, err = b.config.RequestBody(); err != nil {
+		return fmt.Errorf("unable to load benchmark body: %w", err)
+	}
+
 	var ctx context.Context
-	ctx, b.cancelFn = context.WithTimeout(context.Background(), benchTimeout)
+	ctx, b.cancelFn = context.WithTimeout(context.Background(), benchTimeoutFor(stages))
 	req, err := http.NewRequestWithContext(ctx, b.config.HTTP.Method, base, http.NoBody)
 	if err != nil {
 		return err
@@ -72,15 +93,10 @@ func (b *Benchmark) init(base, version string) error {
 		req.Header = make(http.Header)
 	}
 	req.Header.Set("User-Agent", ua)
+	b.request = req
+	b.progress.init(stages)
 
-	slog.Debug(fmt.Sprintf("Using bench config N:%d--C:%d", b.config.N, b.config.C))
-	b.worker = &requester.Work{
-		Request:     req,
-		RequestBody: []byte(b.config.HTTP.Body),
-		N:           b.config.N,
-		C:           b.config.C,
-		H2:          b.config.HTTP.HTTP2,
-	}
+	slog.Debug(fmt.Sprintf("Using bench config N:%d--C:%d--Stages:%d", b.config.N, b.config.C, len(stages)))
 
 	return nil
 }
@@ -94,6 +110,9 @@ func (b *Benchmark) Cancel() {
 	b.mx.Lock()
 	defer b.mx.Unlock()
 	b.canceled = true
+	if b.stopFn != nil {
+		b.stopFn()
+	}
 	if b.cancelFn != nil {
 		b.cancelFn()
 		b.cancelFn = nil
@@ -102,44 +121,127 @@ func (b *Benchmark) Cancel() {
 
 // Canceled checks if the benchmark was canceled.
 func (b *Benchmark) Canceled() bool {
+	b.mx.RLock()
+	defer b.mx.RUnlock()
+
 	return b.canceled
 }
 
+// Done returns a channel closed once the benchmark completes.
+func (b *Benchmark) Done() <-chan struct{} {
+	return b.done
+}
+
+// Progress returns the benchmark progress so far.
+func (b *Benchmark) Progress() Progress {
+	return b.progress.snapshot()
+}
+
 // Run starts a benchmark.
 func (b *Benchmark) Run(cluster, ct string, done func()) {
 	slog.Debug("Running benchmark",
 		slogs.Cluster, cluster,
 		slogs.Context, ct,
 	)
-	buff := new(bytes.Buffer)
-	b.worker.Writer = buff
-	// this call will block until the benchmark is complete or times out.
-	b.worker.Run()
-	b.worker.Stop()
-	if buff.Len() > 0 {
-		if err := b.save(cluster, ct, buff); err != nil {
+	rep := newReport()
+	for i, s := range b.stages {
+		if b.Canceled() {
+			break
+		}
+		r, err := b.runStage(i, s)
+		if err != nil {
+			slog.Error("Benchmark stage failed", slogs.Error, err)
+			break
+		}
+		rep.add(s, r)
+		b.progress.stageDone(failures(r))
+	}
+	if !rep.empty() {
+		buff := new(bytes.Buffer)
+		if err := rep.write(buff); err != nil {
+			slog.Error("Benchmark report failed", slogs.Error, err)
+		} else if path, err := b.save(cluster, ct, buff); err != nil {
 			slog.Error("Saving Benchmark", slogs.Error, err)
+		} else if err := SaveResult(path, rep.result()); err != nil {
+			slog.Error("Saving Benchmark results", slogs.Error, err)
 		}
 	}
+	close(b.done)
 	done()
 }
 
-func (b *Benchmark) save(cluster, ct string, r io.Reader) error {
+func (b *Benchmark) runStage(i int, s config.BenchStage) (*requester.Report, error) {
+	d, err := s.StageDuration()
+	if err != nil {
+		return nil, err
+	}
+	n := stageRequests(s, d)
+	buff := new(bytes.Buffer)
+	w := requester.Work{
+		Request:     b.request,
+		RequestFunc: b.newRequest,
+		N:           n,
+		C:           s.C,
+		QPS:         s.WorkerQPS(),
+		H2:          b.config.HTTP.HTTP2,
+		Output:      reportTmpl,
+		Writer:      buff,
+	}
+	w.Init()
+	var once sync.Once
+	stop := func() { once.Do(w.Stop) }
+
+	b.mx.Lock()
+	b.stopFn = stop
+	b.mx.Unlock()
+	b.progress.stageStarted(i, d)
+	if d > 0 {
+		t := time.AfterFunc(d, stop)
+		defer t.Stop()
+	}
+	// this call will block until the stage is complete, stopped or times out.
+	w.Run()
+
+	b.mx.Lock()
+	b.stopFn = nil
+	b.mx.Unlock()
+
+	var r requester.Report
+	if err := json.Unmarshal(bytes.TrimSpace(buff.Bytes()), &r); err != nil {
+		return nil, fmt.Errorf("unable to read stage #%d report: %w", i+1, err)
+	}
+
+	return &r, nil
+}
+
+func (b *Benchmark) newRequest() *http.Request {
+	ctx := httptrace.WithClientTrace(b.request.Context(), b.progress.trace())
+	req := b.request.Clone(ctx)
+	if len(b.body) > 0 {
+		req.Body = io.NopCloser(bytes.NewReader(b.body))
+		req.ContentLength = int64(len(b.body))
+	}
+	b.progress.sent()
+
+	return req
+}
+
+func (b *Benchmark) save(cluster, ct string, r io.Reader) (string, error) {
 	ns, n := client.Namespaced(b.config.Name)
 	n = strings.ReplaceAll(n, "|", "_")
 	n = strings.ReplaceAll(n, ":", "_")
 	dir, err := config.EnsureBenchmarksDir(cluster, ct)
 	if err != nil {
-		return err
+		return "", err
 	}
 	bf := filepath.Join(dir, fmt.Sprintf(benchFmat, ns, n, time.Now().UnixNano()))
 	if e := data.EnsureDirPath(bf, data.DefaultDirMod); e != nil {
-		return e
+		return "", e
 	}
 
 	f, err := os.Create(bf)
 	if err != nil {
-		return err
+		return "", err
 	}
 	defer func() {
 		if e := f.Close(); e != nil {
@@ -150,8 +252,57 @@ func (b *Benchmark) save(cluster, ct string, r io.Reader) error {
 		}
 	}()
 	if _, err = io.Copy(f, r); err != nil {
-		return err
+		return "", err
 	}
 
-	return nil
+	return bf, nil
+}
+
+// benchTimeoutFor returns the overall run timeout for the given stages.
+func benchTimeoutFor(ss []config.BenchStage) time.Duration {
+	var total time.Duration
+	for _, s := range ss {
+		if d, err := s.StageDuration(); err == nil {
+			total += d
+		}
+	}
+
+	return max(benchTimeout, total+benchGrace)
+}
+
+// stageRequests returns the number of requests to issue for a given stage.
+// Duration stages issue enough requests to sustain their rate for the whole
+// stage and are stopped once the duration elapses.
+func stageRequests(s config.BenchStage, d time.Duration) int {
+	if d == 0 {
+		return s.N
+	}
+	n := maxStageRequests
+	if s.QPS > 0 {
+		n = int(math.Ceil(s.QPS * d.Seconds()))
+	}
+	if s.C <= 0 {
+		return n
+	}
+	// Hey splits requests evenly across workers.
+	n = max(n, s.C)
+	if r := n % s.C; r != 0 {
+		n += s.C - r
+	}
+
+	return n
+}
+
+func failures(r *requester.Report) int64 {
+	var n int64
+	for code, count := range r.StatusCodeDist {
+		if code < 200 || code >= 300 {
+			n += int64(count)
+		}
+	}
+	for _, count := range r.ErrorDist {
+		n += int64(count)
+	}
+
+	return n
 }
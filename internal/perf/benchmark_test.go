@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package perf_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBenchmarkRunStages(t *testing.T) {
+	var hits atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bb, _ := io.ReadAll(r.Body)
+		if string(bb) != "fred" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		hits.Add(1)
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	bodyFile := filepath.Join(dir, "body.txt")
+	require.NoError(t, os.WriteFile(bodyFile, []byte("fred"), 0o600))
+	config.AppBenchmarksDir = dir
+
+	cfg := config.BenchConfig{
+		Name: "default/fred",
+		HTTP: config.HTTP{Method: http.MethodPost, BodyFile: bodyFile},
+		Stages: []config.BenchStage{
+			{C: 1, N: 5},
+			{C: 2, QPS: 20, Duration: "200ms"},
+		},
+	}
+	b, err := perf.NewBenchmark(srv.URL, "0.0.0", &cfg)
+	require.NoError(t, err)
+
+	b.Run("c1", "ct1", func() {})
+	select {
+	case <-b.Done():
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "benchmark did not complete")
+	}
+
+	p := b.Progress()
+	assert.Equal(t, 2, p.Stage)
+	assert.Equal(t, 2, p.Stages)
+	assert.Equal(t, hits.Load(), p.Requests)
+	assert.Zero(t, p.Errors)
+
+	ff, err := filepath.Glob(filepath.Join(dir, "c1", "ct1", "*.txt"))
+	require.NoError(t, err)
+	require.Len(t, ff, 1)
+	r, err := perf.LoadResult(ff[0])
+	require.NoError(t, err)
+	assert.Equal(t, int(hits.Load()), r.OK())
+	assert.Zero(t, r.Failed())
+	assert.Greater(t, r.OK(), 5)
+}
+
+func TestBenchmarkRunAllFailed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	url := srv.URL
+	srv.Close()
+
+	dir := t.TempDir()
+	config.AppBenchmarksDir = dir
+
+	cfg := config.BenchConfig{
+		Name: "default/fred",
+		HTTP: config.HTTP{Method: http.MethodGet},
+		Stages: []config.BenchStage{
+			{C: 1, N: 3},
+			{C: 2, QPS: 20, Duration: "200ms"},
+		},
+	}
+	b, err := perf.NewBenchmark(url, "0.0.0", &cfg)
+	require.NoError(t, err)
+
+	b.Run("c1", "ct1", func() {})
+	select {
+	case <-b.Done():
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "benchmark did not complete")
+	}
+
+	p := b.Progress()
+	assert.Equal(t, 2, p.Stage)
+	assert.Equal(t, p.Requests, p.Errors)
+
+	ff, err := filepath.Glob(filepath.Join(dir, "c1", "ct1", "*.txt"))
+	require.NoError(t, err)
+	require.Len(t, ff, 1)
+	r, err := perf.LoadResult(ff[0])
+	require.NoError(t, err)
+	assert.Zero(t, r.OK())
+	assert.Greater(t, r.Failed(), 3)
+	assert.NotEmpty(t, r.Errors)
+}
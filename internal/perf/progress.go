@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package perf
+
+import (
+	"fmt"
+	"net/http/httptrace"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+)
+
+// Progress represents a benchmark progress snapshot.
+type Progress struct {
+	// Stage tracks the current stage starting at 1.
+	Stage int
+	// Stages tracks the number of load stages.
+	Stages int
+	// Requests tracks the number of requests sent so far.
+	Requests int64
+	// Errors tracks the number of failed requests so far.
+	Errors int64
+	// Elapsed tracks the time spent in the current stage.
+	Elapsed time.Duration
+	// Duration tracks the current stage duration if any.
+	Duration time.Duration
+	// Total tracks the current stage request count when not time bound.
+	Total int
+	// StageRequests tracks the number of requests sent in the current stage.
+	StageRequests int64
+}
+
+// Percent returns the current stage completion percentage.
+func (p Progress) Percent() int {
+	switch {
+	case p.Duration > 0:
+		return min(int(p.Elapsed*100/p.Duration), 100)
+	case p.Total > 0:
+		return min(int(p.StageRequests*100/int64(p.Total)), 100)
+	default:
+		return 0
+	}
+}
+
+// String returns a short progress status.
+func (p Progress) String() string {
+	var b strings.Builder
+	b.WriteString("Bench")
+	if p.Stages > 1 {
+		fmt.Fprintf(&b, " %d/%d", p.Stage, p.Stages)
+	}
+	if p.Duration > 0 || p.Total > 0 {
+		fmt.Fprintf(&b, " %d%%", p.Percent())
+	}
+	fmt.Fprintf(&b, " %s req", toCount(p.Requests))
+	if p.Errors > 0 {
+		fmt.Fprintf(&b, " %s err", toCount(p.Errors))
+	}
+
+	return b.String()
+}
+
+// progress tracks a benchmark progress while it runs.
+type progress struct {
+	stages   []config.BenchStage
+	stage    int
+	started  time.Time
+	duration time.Duration
+	base     int64
+	requests atomic.Int64
+	errors   atomic.Int64
+	failed   atomic.Int64
+	mx       sync.RWMutex
+}
+
+func (p *progress) init(ss []config.BenchStage) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+	p.stages = ss
+}
+
+func (p *progress) stageStarted(i int, d time.Duration) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+	p.stage, p.started, p.duration = i, time.Now(), d
+	p.base = p.requests.Load()
+	p.errors.Store(0)
+}
+
+// stageDone records a completed stage failures.
+func (p *progress) stageDone(failed int64) {
+	p.failed.Add(failed)
+	p.errors.Store(0)
+}
+
+func (p *progress) sent() {
+	p.requests.Add(1)
+}
+
+// trace tracks transport errors while a stage is in flight.
+func (p *progress) trace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		ConnectDone: func(_, _ string, err error) {
+			if err != nil {
+				p.errors.Add(1)
+			}
+		},
+		WroteRequest: func(i httptrace.WroteRequestInfo) {
+			if i.Err != nil {
+				p.errors.Add(1)
+			}
+		},
+	}
+}
+
+func (p *progress) snapshot() Progress {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	reqs := p.requests.Load()
+	ps := Progress{
+		Stage:         p.stage + 1,
+		Stages:        len(p.stages),
+		Requests:      reqs,
+		Errors:        p.failed.Load() + p.errors.Load(),
+		Duration:      p.duration,
+		StageRequests: reqs - p.base,
+	}
+	if !p.started.IsZero() {
+		ps.Elapsed = time.Since(p.started)
+	}
+	if p.duration == 0 && p.stage < len(p.stages) {
+		ps.Total = p.stages[p.stage].N
+	}
+
+	return ps
+}
+
+// Helpers...
+
+func toCount(n int64) string {
+	switch {
+	case n >= 1_000_000:
+		return strconv.FormatFloat(float64(n)/1_000_000, 'f', 1, 64) + "m"
+	case n >= 1_000:
+		return strconv.FormatFloat(float64(n)/1_000, 'f', 1, 64) + "k"
+	default:
+		return strconv.FormatInt(n, 10)
+	}
+}
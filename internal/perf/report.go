@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package perf
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rakyll/hey/requester"
+)
+
+const (
+	// reportTmpl instructs hey to dump the raw report fields we aggregate as json.
+	// Hey's averages are NaN when all requests fail which can't be encoded as json.
+	reportTmpl = `{"Total":{{ jsonify .Total }},"NumRes":{{ jsonify .NumRes }},"SizeTotal":{{ jsonify .SizeTotal }},` +
+		`"Lats":{{ jsonify .Lats }},"StatusCodeDist":{{ jsonify .StatusCodeDist }},"ErrorDist":{{ jsonify .ErrorDist }}}`
+	barChar = "■"
+)
+
+var latencyPercentiles = []int{10, 25, 50, 75, 90, 95, 99}
+
+// stageReport tracks a completed load stage.
+type stageReport struct {
+	stage    config.BenchStage
+	total    time.Duration
+	requests int64
+}
+
+// report aggregates hey reports across load stages.
+type report struct {
+	stages    []stageReport
+	lats      []float64
+	codes     map[int]int
+	errs      map[string]int
+	total     time.Duration
+	numRes    int64
+	sizeTotal int64
+}
+
+func newReport() *report {
+	return &report{
+		codes: make(map[int]int),
+		errs:  make(map[string]int),
+	}
+}
+
+// add merges a stage hey report.
+func (r *report) add(s config.BenchStage, rep *requester.Report) {
+	r.stages = append(r.stages, stageReport{stage: s, total: rep.Total, requests: rep.NumRes})
+	r.lats = append(r.lats, rep.Lats...)
+	for code, n := range rep.StatusCodeDist {
+		r.codes[code] += n
+	}
+	for e, n := range rep.ErrorDist {
+		r.errs[e] += n
+	}
+	r.total += rep.Total
+	r.numRes += rep.NumRes
+	r.sizeTotal += rep.SizeTotal
+}
+
+// empty checks if any requests were recorded.
+func (r *report) empty() bool {
+	return r.numRes == 0
+}
+
+// result returns the structured run results.
+func (r *report) result() *Result {
+	res := Result{
+		Total:       r.total.Seconds(),
+		StatusCodes: maps.Clone(r.codes),
+		Errors:      maps.Clone(r.errs),
+	}
+	if r.total > 0 {
+		res.RPS = float64(r.numRes) / r.total.Seconds()
+	}
+	if len(r.lats) == 0 {
+		return &res
+	}
+
+	lats := slices.Clone(r.lats)
+	slices.Sort(lats)
+	var sum float64
+	for _, l := range lats {
+		sum += l
+	}
+	res.Fastest, res.Slowest = lats[0], lats[len(lats)-1]
+	res.Average = sum / float64(len(lats))
+	res.Latencies = latencies(lats)
+	res.Histogram = histogram(lats)
+
+	return &res
+}
+
+// write dumps a hey like text report.
+func (r *report) write(w io.Writer) error {
+	res := r.result()
+	var b strings.Builder
+	b.WriteString("\nSummary:\n")
+	fmt.Fprintf(&b, "  Total:\t%4.4f secs\n", res.Total)
+	fmt.Fprintf(&b, "  Slowest:\t%4.4f secs\n", res.Slowest)
+	fmt.Fprintf(&b, "  Fastest:\t%4.4f secs\n", res.Fastest)
+	fmt.Fprintf(&b, "  Average:\t%4.4f secs\n", res.Average)
+	fmt.Fprintf(&b, "  Requests/sec:\t%4.4f\n", res.RPS)
+	if r.sizeTotal > 0 && len(r.lats) > 0 {
+		fmt.Fprintf(&b, "\n  Total data:\t%d bytes\n", r.sizeTotal)
+		fmt.Fprintf(&b, "  Size/request:\t%d bytes\n", r.sizeTotal/int64(len(r.lats)))
+	}
+
+	if len(r.stages) > 1 {
+		b.WriteString("\nStages:\n")
+		for i, s := range r.stages {
+			fmt.Fprintf(&b, "  #%d\tconcurrency=%d qps=%s took %4.4f secs for %d requests\n",
+				i+1, s.stage.C, qpsToStr(s.stage.QPS), s.total.Seconds(), s.requests)
+		}
+	}
+
+	b.WriteString("\nResponse time histogram:\n")
+	var maxCount int
+	for _, bu := range res.Histogram {
+		maxCount = max(maxCount, bu.Count)
+	}
+	for _, bu := range res.Histogram {
+		var barLen int
+		if maxCount > 0 {
+			barLen = (bu.Count*40 + maxCount/2) / maxCount
+		}
+		fmt.Fprintf(&b, "  %4.3f [%d]\t|%s\n", bu.Mark, bu.Count, strings.Repeat(barChar, barLen))
+	}
+
+	b.WriteString("\nLatency distribution:\n")
+	for _, l := range res.Latencies {
+		fmt.Fprintf(&b, "  %d%% in %4.4f secs\n", l.Percentile, l.Seconds)
+	}
+
+	b.WriteString("\nStatus code distribution:\n")
+	for _, code := range res.Codes() {
+		fmt.Fprintf(&b, "  [%d]\t%d responses\n", code, res.StatusCodes[code])
+	}
+
+	if len(r.errs) > 0 {
+		b.WriteString("\nError distribution:\n")
+		for _, e := range slices.Sorted(maps.Keys(r.errs)) {
+			fmt.Fprintf(&b, "  [%d]\t%s\n", r.errs[e], e)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+// Helpers...
+
+func qpsToStr(qps float64) string {
+	if qps <= 0 {
+		return "unbound"
+	}
+
+	return fmt.Sprintf("%g", qps)
+}
+
+// latencies computes latency percentiles from sorted latencies.
+func latencies(lats []float64) []Latency {
+	ll := make([]Latency, 0, len(latencyPercentiles))
+	j := 0
+	for i := 0; i < len(lats) && j < len(latencyPercentiles); i++ {
+		if i*100/len(lats) >= latencyPercentiles[j] {
+			ll = append(ll, Latency{Percentile: latencyPercentiles[j], Seconds: lats[i]})
+			j++
+		}
+	}
+
+	return ll
+}
+
+// histogram buckets sorted latencies in 10 evenly spaced buckets.
+func histogram(lats []float64) []Bucket {
+	const bc = 10
+	fastest, slowest := lats[0], lats[len(lats)-1]
+	marks := make([]float64, bc+1)
+	bs := (slowest - fastest) / bc
+	for i := range bc {
+		marks[i] = fastest + bs*float64(i)
+	}
+	marks[bc] = slowest
+
+	bb := make([]Bucket, len(marks))
+	for i, m := range marks {
+		bb[i].Mark = m
+	}
+	var bi int
+	for i := 0; i < len(lats); {
+		if lats[i] <= marks[bi] {
+			bb[bi].Count++
+			i++
+		} else if bi < len(marks)-1 {
+			bi++
+		} else {
+			break
+		}
+	}
+
+	return bb
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package perf
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rakyll/hey/requester"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportResult(t *testing.T) {
+	r := newReport()
+	assert.True(t, r.empty())
+
+	r.add(config.BenchStage{C: 1, N: 4}, &requester.Report{
+		Total:          2 * time.Second,
+		NumRes:         4,
+		Lats:           []float64{0.4, 0.1, 0.3, 0.2},
+		StatusCodeDist: map[int]int{200: 3, 500: 1},
+	})
+	r.add(config.BenchStage{C: 2, QPS: 10, Duration: "1s"}, &requester.Report{
+		Total:          2 * time.Second,
+		NumRes:         6,
+		Lats:           []float64{0.5, 0.6, 0.7, 0.8, 0.9, 1},
+		StatusCodeDist: map[int]int{200: 5},
+		ErrorDist:      map[string]int{"boom": 1},
+	})
+	assert.False(t, r.empty())
+
+	res := r.result()
+	assert.InDelta(t, 4, res.Total, 0.001)
+	assert.InDelta(t, 2.5, res.RPS, 0.001)
+	assert.InDelta(t, 0.1, res.Fastest, 0.001)
+	assert.InDelta(t, 1, res.Slowest, 0.001)
+	assert.InDelta(t, 0.55, res.Average, 0.001)
+	assert.Equal(t, map[int]int{200: 8, 500: 1}, res.StatusCodes)
+	assert.Equal(t, 8, res.OK())
+	assert.Equal(t, 2, res.Failed())
+	p50, ok := res.Percentile(50)
+	assert.True(t, ok)
+	assert.InDelta(t, 0.6, p50, 0.001)
+	assert.Len(t, res.Histogram, 11)
+	var count int
+	for _, b := range res.Histogram {
+		count += b.Count
+	}
+	assert.Equal(t, 10, count)
+}
+
+func TestReportWrite(t *testing.T) {
+	r := newReport()
+	r.add(config.BenchStage{C: 1, N: 2}, &requester.Report{
+		Total:          time.Second,
+		NumRes:         2,
+		Lats:           []float64{0.1, 0.2},
+		StatusCodeDist: map[int]int{200: 2},
+	})
+	r.add(config.BenchStage{C: 2, QPS: 5, Duration: "1s"}, &requester.Report{
+		Total:     time.Second,
+		NumRes:    2,
+		Lats:      []float64{0.3, 0.4},
+		ErrorDist: map[string]int{"dial tcp: connection refused": 2},
+	})
+
+	var buff bytes.Buffer
+	require.NoError(t, r.write(&buff))
+	assert.Contains(t, buff.String(), "  #2\tconcurrency=2 qps=5 took 1.0000 secs for 2 requests\n")
+
+	res, err := ParseResult(buff.String())
+	require.NoError(t, err)
+	e := r.result()
+	assert.InDelta(t, e.Total, res.Total, 0.0001)
+	assert.InDelta(t, e.RPS, res.RPS, 0.0001)
+	assert.InDelta(t, e.Average, res.Average, 0.0001)
+	assert.Equal(t, e.Latencies, res.Latencies)
+	assert.Len(t, res.Histogram, len(e.Histogram))
+	assert.Equal(t, e.StatusCodes, res.StatusCodes)
+	assert.Equal(t, e.Errors, res.Errors)
+}
+
+func TestProgressString(t *testing.T) {
+	uu := map[string]struct {
+		p Progress
+		e string
+	}{
+		"empty": {
+			e: "Bench 0 req",
+		},
+		"requests": {
+			p: Progress{Stage: 1, Stages: 1, Requests: 250, Total: 1000, StageRequests: 250},
+			e: "Bench 25% 250 req",
+		},
+		"duration": {
+			p: Progress{Stage: 2, Stages: 3, Requests: 1_250, Errors: 3, Elapsed: 15 * time.Second, Duration: time.Minute},
+			e: "Bench 2/3 25% 1.2k req 3 err",
+		},
+		"overflow": {
+			p: Progress{Stage: 1, Stages: 1, Requests: 2_500_000, Elapsed: 2 * time.Minute, Duration: time.Minute},
+			e: "Bench 100% 2.5m req",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.p.String())
+		})
+	}
+}
@@ -0,0 +1,262 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package perf
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const resultExt = ".json"
+
+var (
+	summaryRx = regexp.MustCompile(`^\s*(Total|Slowest|Fastest|Average|Requests/sec):\s+([0-9.]+)`)
+	bucketRx  = regexp.MustCompile(`^\s*([0-9.]+)\s+\[(\d+)\]`)
+	latencyRx = regexp.MustCompile(`^\s*(\d+)%+\s+in\s+([0-9.]+)\s+secs`)
+	statusRx  = regexp.MustCompile(`^\s*\[(\d+)\]\s+(\d+)\s+responses`)
+	errorRx   = regexp.MustCompile(`^\s*\[(\d+)\]\s+(.+)$`)
+)
+
+// Result represents a structured benchmark run outcome.
+type Result struct {
+	Total       float64        `json:"total"`
+	Slowest     float64        `json:"slowest"`
+	Fastest     float64        `json:"fastest"`
+	Average     float64        `json:"average"`
+	RPS         float64        `json:"rps"`
+	Latencies   []Latency      `json:"latencies,omitempty"`
+	StatusCodes map[int]int    `json:"statusCodes,omitempty"`
+	Errors      map[string]int `json:"errors,omitempty"`
+	Histogram   []Bucket       `json:"histogram,omitempty"`
+}
+
+// Latency represents a latency percentile in seconds.
+type Latency struct {
+	Percentile int     `json:"percentile"`
+	Seconds    float64 `json:"seconds"`
+}
+
+// Bucket represents a response time histogram bucket.
+type Bucket struct {
+	Mark  float64 `json:"mark"`
+	Count int     `json:"count"`
+}
+
+// ParseResult extracts a result from a benchmark text report.
+func ParseResult(report string) (*Result, error) {
+	r := Result{
+		StatusCodes: make(map[int]int),
+		Errors:      make(map[string]int),
+	}
+	var section string
+	scanner := bufio.NewScanner(strings.NewReader(report))
+	for scanner.Scan() {
+		l := scanner.Text()
+		switch {
+		case strings.HasPrefix(l, "Summary:"),
+			strings.HasPrefix(l, "Response time histogram:"),
+			strings.HasPrefix(l, "Latency distribution:"),
+			strings.HasPrefix(l, "Details"),
+			strings.HasPrefix(l, "Stages:"),
+			strings.HasPrefix(l, "Status code distribution:"),
+			strings.HasPrefix(l, "Error distribution:"):
+			section, _, _ = strings.Cut(l, ":")
+			section, _, _ = strings.Cut(section, " (")
+			continue
+		}
+		r.parseLine(section, l)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if section == "" {
+		return nil, errors.New("no benchmark summary found")
+	}
+
+	return &r, nil
+}
+
+func (r *Result) parseLine(section, l string) {
+	switch section {
+	case "Summary":
+		if m := summaryRx.FindStringSubmatch(l); m != nil {
+			v, _ := strconv.ParseFloat(m[2], 64)
+			switch m[1] {
+			case "Total":
+				r.Total = v
+			case "Slowest":
+				r.Slowest = v
+			case "Fastest":
+				r.Fastest = v
+			case "Average":
+				r.Average = v
+			default:
+				r.RPS = v
+			}
+		}
+	case "Response time histogram":
+		if m := bucketRx.FindStringSubmatch(l); m != nil {
+			mark, _ := strconv.ParseFloat(m[1], 64)
+			count, _ := strconv.Atoi(m[2])
+			r.Histogram = append(r.Histogram, Bucket{Mark: mark, Count: count})
+		}
+	case "Latency distribution":
+		if m := latencyRx.FindStringSubmatch(l); m != nil {
+			p, _ := strconv.Atoi(m[1])
+			v, _ := strconv.ParseFloat(m[2], 64)
+			r.Latencies = append(r.Latencies, Latency{Percentile: p, Seconds: v})
+		}
+	case "Status code distribution":
+		if m := statusRx.FindStringSubmatch(l); m != nil {
+			code, _ := strconv.Atoi(m[1])
+			count, _ := strconv.Atoi(m[2])
+			r.StatusCodes[code] += count
+		}
+	case "Error distribution":
+		if m := errorRx.FindStringSubmatch(l); m != nil {
+			count, _ := strconv.Atoi(m[1])
+			r.Errors[strings.TrimSpace(m[2])] += count
+		}
+	}
+}
+
+// Percentile returns the latency in seconds for a given percentile.
+func (r *Result) Percentile(p int) (float64, bool) {
+	for _, l := range r.Latencies {
+		if l.Percentile == p {
+			return l.Seconds, true
+		}
+	}
+
+	return 0, false
+}
+
+// Codes returns the sorted collection of status codes.
+func (r *Result) Codes() []int {
+	cc := make([]int, 0, len(r.StatusCodes))
+	for c := range r.StatusCodes {
+		cc = append(cc, c)
+	}
+	slices.Sort(cc)
+
+	return cc
+}
+
+// OK returns the number of 2xx responses.
+func (r *Result) OK() int {
+	var n int
+	for c, count := range r.StatusCodes {
+		if c >= 200 && c < 300 {
+			n += count
+		}
+	}
+
+	return n
+}
+
+// Failed returns the number of non 2xx responses and request errors.
+func (r *Result) Failed() int {
+	var n int
+	for c, count := range r.StatusCodes {
+		if c < 200 || c >= 300 {
+			n += count
+		}
+	}
+	for _, count := range r.Errors {
+		n += count
+	}
+
+	return n
+}
+
+// ResultPath returns the structured result file path for a given report.
+func ResultPath(report string) string {
+	return strings.TrimSuffix(report, ".txt") + resultExt
+}
+
+// IsResultFile checks if a file holds structured results.
+func IsResultFile(path string) bool {
+	return strings.HasSuffix(path, resultExt)
+}
+
+// LoadResult loads the structured results for a given report. Reports
+// predating structured results are parsed on the fly.
+func LoadResult(report string) (*Result, error) {
+	bb, err := os.ReadFile(ResultPath(report))
+	if err == nil {
+		var r Result
+		if err := json.Unmarshal(bb, &r); err != nil {
+			return nil, fmt.Errorf("unable to load bench results %s: %w", ResultPath(report), err)
+		}
+		return &r, nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	bb, err = os.ReadFile(report)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseResult(string(bb))
+}
+
+// SaveResult saves structured results alongside a given report.
+func SaveResult(report string, r *Result) error {
+	bb, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(ResultPath(report), bb, 0o600)
+}
+
+// Delta represents a metric difference between two runs.
+type Delta struct {
+	Metric string
+	A, B   float64
+	Unit   string
+}
+
+// Change returns the relative change from A to B in percent.
+func (d Delta) Change() (float64, bool) {
+	if d.A == 0 {
+		return 0, false
+	}
+
+	return (d.B - d.A) / d.A * 100, true
+}
+
+// Compare computes key metric deltas between two runs.
+func Compare(a, b *Result) []Delta {
+	dd := []Delta{
+		{Metric: "Total", A: a.Total, B: b.Total, Unit: "s"},
+		{Metric: "Requests/sec", A: a.RPS, B: b.RPS},
+		{Metric: "Fastest", A: a.Fastest, B: b.Fastest, Unit: "s"},
+		{Metric: "Average", A: a.Average, B: b.Average, Unit: "s"},
+		{Metric: "Slowest", A: a.Slowest, B: b.Slowest, Unit: "s"},
+	}
+	for _, p := range KeyPercentiles {
+		pa, _ := a.Percentile(p)
+		pb, _ := b.Percentile(p)
+		dd = append(dd, Delta{Metric: fmt.Sprintf("p%d", p), A: pa, B: pb, Unit: "s"})
+	}
+	dd = append(dd,
+		Delta{Metric: "2xx", A: float64(a.OK()), B: float64(b.OK())},
+		Delta{Metric: "Failed", A: float64(a.Failed()), B: float64(b.Failed())},
+	)
+
+	return dd
+}
+
+// KeyPercentiles tracks the latency percentiles of interest.
+var KeyPercentiles = []int{50, 90, 99}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package perf_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseResult(t *testing.T) {
+	bb, err := os.ReadFile("testdata/ok.txt")
+	require.NoError(t, err)
+
+	r, err := perf.ParseResult(string(bb))
+	require.NoError(t, err)
+
+	assert.InDelta(t, 3.3544, r.Total, 0.0001)
+	assert.InDelta(t, 0.1031, r.Slowest, 0.0001)
+	assert.InDelta(t, 0.0310, r.Fastest, 0.0001)
+	assert.InDelta(t, 0.0335, r.Average, 0.0001)
+	assert.InDelta(t, 29.8116, r.RPS, 0.0001)
+	assert.Len(t, r.Histogram, 11)
+	assert.Equal(t, perf.Bucket{Mark: 0.038, Count: 92}, r.Histogram[1])
+	assert.Len(t, r.Latencies, 7)
+	p99, ok := r.Percentile(99)
+	assert.True(t, ok)
+	assert.InDelta(t, 0.1031, p99, 0.0001)
+	_, ok = r.Percentile(42)
+	assert.False(t, ok)
+	assert.Equal(t, map[int]int{200: 100}, r.StatusCodes)
+	assert.Equal(t, []int{200}, r.Codes())
+	assert.Equal(t, 100, r.OK())
+	assert.Equal(t, 0, r.Failed())
+}
+
+func TestParseResultToast(t *testing.T) {
+	bb, err := os.ReadFile("testdata/toast.txt")
+	require.NoError(t, err)
+
+	r, err := perf.ParseResult(string(bb))
+	require.NoError(t, err)
+
+	assert.InDelta(t, 816.6403, r.Total, 0.0001)
+	assert.Empty(t, r.Histogram)
+	assert.Empty(t, r.Latencies)
+	assert.Equal(t, 0, r.OK())
+	assert.Equal(t, 10, r.Failed())
+}
+
+func TestParseResultInvalid(t *testing.T) {
+	_, err := perf.ParseResult("blah")
+	require.Error(t, err)
+}
+
+func TestLoadResult(t *testing.T) {
+	dir := t.TempDir()
+	bb, err := os.ReadFile("testdata/ok.txt")
+	require.NoError(t, err)
+	report := filepath.Join(dir, "default_fred_1.txt")
+	require.NoError(t, os.WriteFile(report, bb, 0o600))
+
+	r, err := perf.LoadResult(report)
+	require.NoError(t, err)
+	assert.InDelta(t, 29.8116, r.RPS, 0.0001)
+
+	r.RPS = 42
+	require.NoError(t, perf.SaveResult(report, r))
+	assert.Equal(t, filepath.Join(dir, "default_fred_1.json"), perf.ResultPath(report))
+	assert.True(t, perf.IsResultFile(perf.ResultPath(report)))
+
+	r, err = perf.LoadResult(report)
+	require.NoError(t, err)
+	assert.InDelta(t, 42.0, r.RPS, 0.0001)
+}
+
+func TestCompare(t *testing.T) {
+	a := perf.Result{
+		Total:       10,
+		RPS:         100,
+		Latencies:   []perf.Latency{{Percentile: 50, Seconds: 0.1}, {Percentile: 99, Seconds: 0.5}},
+		StatusCodes: map[int]int{200: 90, 500: 10},
+	}
+	b := perf.Result{
+		Total:       5,
+		RPS:         200,
+		Latencies:   []perf.Latency{{Percentile: 50, Seconds: 0.05}, {Percentile: 99, Seconds: 1}},
+		StatusCodes: map[int]int{200: 100},
+		Errors:      map[string]int{"boom": 2},
+	}
+
+	dd := perf.Compare(&a, &b)
+	assert.Len(t, dd, 10)
+
+	assert.Equal(t, "Requests/sec", dd[1].Metric)
+	c, ok := dd[1].Change()
+	assert.True(t, ok)
+	assert.InDelta(t, 100.0, c, 0.001)
+
+	assert.Equal(t, "p50", dd[5].Metric)
+	assert.InDelta(t, 0.1, dd[5].A, 0.001)
+	assert.Equal(t, "p90", dd[6].Metric)
+	_, ok = dd[6].Change()
+	assert.False(t, ok)
+
+	assert.Equal(t, perf.Delta{Metric: "2xx", A: 90, B: 100}, dd[8])
+	assert.Equal(t, perf.Delta{Metric: "Failed", A: 10, B: 2}, dd[9])
+}
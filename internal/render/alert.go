@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// AlertFiring represents a firing alert state.
+	AlertFiring = "FIRING"
+
+	// AlertResolved represents a resolved alert state.
+	AlertResolved = "RESOLVED"
+
+	alertStateColName = "STATE"
+	alertAckColName   = "ACK"
+)
+
+// Alert renders alert notifications to screen.
+type Alert struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Alert) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+
+		stateIdx, ok := h.IndexOf(alertStateColName, true)
+		if !ok {
+			return c
+		}
+		if strings.TrimSpace(re.Row.Fields[stateIdx]) != AlertFiring {
+			return model1.CompletedColor
+		}
+		if idx, ok := h.IndexOf(alertAckColName, true); ok && re.Row.Fields[idx] == "true" {
+			return model1.CompletedColor
+		}
+		idx, ok := h.IndexOf(sevColName, true)
+		if !ok {
+			return c
+		}
+		if strings.TrimSpace(re.Row.Fields[idx]) == config.AlertCritical {
+			return model1.ErrColor
+		}
+
+		return tcell.ColorDarkOrange
+	}
+}
+
+// Header returns a header row.
+func (Alert) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "SEVERITY"},
+		model1.HeaderColumn{Name: "STATE"},
+		model1.HeaderColumn{Name: "ACK"},
+		model1.HeaderColumn{Name: "RULE"},
+		model1.HeaderColumn{Name: "RESOURCE"},
+		model1.HeaderColumn{Name: "MESSAGE"},
+		model1.HeaderColumn{Name: "COUNT", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "FIRST SEEN", Attrs: model1.Attrs{Time: true}},
+		model1.HeaderColumn{Name: "LAST SEEN", Attrs: model1.Attrs{Time: true}},
+	}
+}
+
+// Render renders an alert notification to screen.
+func (Alert) Render(o any, _ string, r *model1.Row) error {
+	a, ok := o.(AlertRes)
+	if !ok {
+		return fmt.Errorf("expected AlertRes, but got %T", o)
+	}
+
+	state := AlertResolved
+	if a.Active {
+		state = AlertFiring
+	}
+	r.ID = a.ID
+	r.Fields = model1.Fields{
+		a.Severity,
+		state,
+		strconv.FormatBool(a.Acked),
+		a.Rule,
+		a.Resource(),
+		a.Message,
+		strconv.Itoa(a.Count),
+		ToAge(metav1.NewTime(a.FirstSeen)),
+		ToAge(metav1.NewTime(a.LastSeen)),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// AlertRes represents an alert notification.
+type AlertRes struct {
+	ID                  string
+	Rule                string
+	Kind                string
+	Severity            string
+	GVR                 *client.GVR
+	Path                string
+	Message             string
+	Count               int
+	FirstSeen, LastSeen time.Time
+	Acked, Active       bool
+}
+
+// Resource returns the alerting resource kind and path.
+func (a AlertRes) Resource() string {
+	if a.GVR == nil {
+		return a.Path
+	}
+
+	return a.GVR.R() + ":" + a.Path
+}
+
+// GetObjectKind returns a schema object.
+func (AlertRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (a AlertRes) DeepCopyObject() runtime.Object {
+	return a
+}
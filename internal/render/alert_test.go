@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tcell/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertRender(t *testing.T) {
+	at := time.Now().Add(-time.Minute)
+	a := render.AlertRes{
+		ID:        "crashy|fred/p1",
+		Rule:      "crashy",
+		Severity:  "critical",
+		GVR:       client.PodGVR,
+		Path:      "fred/p1",
+		Message:   "4 restarts in the last 10m0s",
+		Count:     2,
+		FirstSeen: at,
+		LastSeen:  at,
+		Active:    true,
+	}
+
+	var (
+		r  model1.Row
+		re render.Alert
+	)
+	require.NoError(t, re.Render(a, "", &r))
+	assert.Equal(t, "crashy|fred/p1", r.ID)
+	assert.Equal(t, model1.Fields{"critical", "FIRING", "false", "crashy", "pods:fred/p1", "4 restarts in the last 10m0s", "2"}, r.Fields[:7])
+
+	h := re.Header("")
+	assert.Equal(t, model1.ErrColor, re.ColorerFunc()("", h, &model1.RowEvent{Row: r}))
+
+	a.Severity = "warn"
+	require.NoError(t, re.Render(a, "", &r))
+	assert.Equal(t, tcell.ColorDarkOrange, re.ColorerFunc()("", h, &model1.RowEvent{Row: r}))
+
+	a.Acked = true
+	require.NoError(t, re.Render(a, "", &r))
+	assert.Equal(t, model1.CompletedColor, re.ColorerFunc()("", h, &model1.RowEvent{Row: r}))
+}
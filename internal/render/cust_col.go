@@ -14,7 +14,7 @@ import (
 	"k8s.io/kubectl/pkg/cmd/get"
 )
 
-var fullRX = regexp.MustCompile(`^([\w\s%/-]+):?([\w\W]*?)\|?([NTWSLRH]{0,3})$`)
+var fullRX = regexp.MustCompile(`^([\w\s%/-]+):?([\w\W]*?)\|?([NTWSLRHCM]{0,3})$`)
 
 type colAttr byte
 
@@ -26,6 +26,8 @@ const (
 	alignLeft  colAttr = 'L'
 	alignRight colAttr = 'R'
 	hide       colAttr = 'H'
+	trendCPU   colAttr = 'C'
+	trendMEM   colAttr = 'M'
 )
 
 type colAttrs struct {
@@ -33,6 +35,8 @@ type colAttrs struct {
 	mx       bool
 	mxc      bool
 	mxm      bool
+	trendCPU bool
+	trendMEM bool
 	time     bool
 	wide     bool
 	show     bool
@@ -61,6 +65,10 @@ func newColFlags(flags string) colAttrs {
 			c.time = true
 		case number:
 			c.capacity, c.align = true, tview.AlignRight
+		case trendCPU:
+			c.trendCPU, c.mx = true, true
+		case trendMEM:
+			c.trendMEM, c.mx = true, true
 		default:
 			slog.Warn("Unknown column attribute", slogs.Attr, b)
 		}
@@ -106,6 +114,8 @@ func (c colDef) toHeaderCol() model1.HeaderColumn {
 			MX:       c.mx,
 			MXC:      c.mxc,
 			MXM:      c.mxm,
+			TrendCPU: c.trendCPU,
+			TrendMEM: c.trendMEM,
 			Hide:     c.hide,
 			Capacity: c.capacity,
 		},
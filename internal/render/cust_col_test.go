@@ -93,6 +93,33 @@ func TestCustCol_parse(t *testing.T) {
 			},
 		},
 
+		"cpu-trend": {
+			s: "CPU-TREND|C",
+			e: colDef{
+				name: "CPU-TREND",
+				idx:  -1,
+				colAttrs: colAttrs{
+					align:    tview.AlignLeft,
+					mx:       true,
+					trendCPU: true,
+				},
+			},
+		},
+
+		"mem-trend-wide": {
+			s: "MEM-TREND|MW",
+			e: colDef{
+				name: "MEM-TREND",
+				idx:  -1,
+				colAttrs: colAttrs{
+					align:    tview.AlignLeft,
+					mx:       true,
+					trendMEM: true,
+					wide:     true,
+				},
+			},
+		},
+
 		"complex": {
 			s: "BLEE:.spec.addresses[?(@.type == 'CiliumInternalIP')].ip",
 			e: colDef{
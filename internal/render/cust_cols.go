@@ -150,6 +150,14 @@ func hydrate(o runtime.Object, cc ColumnSpecs, parsers []*jsonpath.JSONPath, rh
 			continue
 		}
 		parser := parsers[idx]
+		if isTrendCol(cc[idx].Header) {
+			// Trend columns are filled out from the resource metrics series.
+			cols[idx] = RenderedCol{
+				Header: cc[idx].Header,
+				Value:  NAValue,
+			}
+			continue
+		}
 		if parser == nil {
 			ix, ok := rh.IndexOf(cc[idx].Header.Name, true)
 			if !ok {
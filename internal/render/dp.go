@@ -21,6 +21,7 @@ var defaultDPHeader = model1.Header{
 	model1.HeaderColumn{Name: "NAMESPACE"},
 	model1.HeaderColumn{Name: "NAME"},
 	model1.HeaderColumn{Name: "VS", Attrs: model1.Attrs{VS: true}},
+	model1.HeaderColumn{Name: "VULN", Attrs: model1.Attrs{VS: true}},
 	model1.HeaderColumn{Name: "READY", Attrs: model1.Attrs{Align: tview.AlignRight}},
 	model1.HeaderColumn{Name: "UP-TO-DATE", Attrs: model1.Attrs{Align: tview.AlignRight}},
 	model1.HeaderColumn{Name: "AVAILABLE", Attrs: model1.Attrs{Align: tview.AlignRight}},
@@ -93,6 +94,7 @@ func (d Deployment) defaultRow(raw *unstructured.Unstructured, r *model1.Row) er
 		dp.Namespace,
 		dp.Name,
 		computeVulScore(dp.Namespace, dp.Labels, &dp.Spec.Template.Spec),
+		computeVulVerdict(dp.Namespace, dp.Labels, &dp.Spec.Template.Spec),
 		strconv.Itoa(int(dp.Status.AvailableReplicas)) + "/" + strconv.Itoa(int(desired)),
 		strconv.Itoa(int(dp.Status.UpdatedReplicas)),
 		strconv.Itoa(int(dp.Status.AvailableReplicas)),
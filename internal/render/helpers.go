@@ -45,6 +45,18 @@ func computeVulScore(ns string, lbls map[string]string, spec *v1.PodSpec) string
 	return sc
 }
 
+func computeVulVerdict(ns string, lbls map[string]string, spec *v1.PodSpec) string {
+	if vul.ImgScanner == nil || !vul.ImgScanner.IsInitialized() || vul.ImgScanner.ShouldExcludes(ns, lbls) {
+		return NAValue
+	}
+	v := vul.ImgScanner.Verdict(ns, lbls, ExtractImages(spec)...)
+	if v.State == vul.VerdictNA {
+		return NAValue
+	}
+
+	return v.String()
+}
+
 func runesToNum(rr []rune) int64 {
 	var r int64
 	var m int64 = 1
@@ -255,6 +267,39 @@ func toMi(v int64) string {
 	return strconv.Itoa(int(client.ToMB(v)))
 }
 
+func toBytes(v int64) string {
+	if v <= 0 {
+		return ZeroValue
+	}
+	const unit = 1024
+	if v < unit {
+		return strconv.FormatInt(v, 10) + "B"
+	}
+	div, exp := int64(unit), 0
+	for n := v / unit; n >= unit && exp < 2; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return strconv.FormatFloat(float64(v)/float64(div), 'f', 1, 64) + []string{"Ki", "Mi", "Gi"}[exp]
+}
+
+func toKiRate(v float64) string {
+	if v <= 0 {
+		return ZeroValue
+	}
+
+	return strconv.FormatFloat(v/1024, 'f', 0, 64)
+}
+
+func toRate(v float64) string {
+	if v <= 0 {
+		return ZeroValue
+	}
+
+	return strconv.FormatFloat(v, 'f', 1, 64)
+}
+
 func boolPtrToStr(b *bool) string {
 	if b == nil {
 		return "false"
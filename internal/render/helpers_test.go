@@ -56,7 +56,7 @@ func TestTableHydrate(t *testing.T) {
 	re := NewPod()
 	require.NoError(t, model1.Hydrate("blee", oo, rr, re))
 	assert.Len(t, rr, 1)
-	assert.Len(t, rr[0].Fields, 26)
+	assert.Len(t, rr[0].Fields, 30)
 }
 
 func TestToAge(t *testing.T) {
@@ -407,6 +407,54 @@ func TestToMi(t *testing.T) {
 	}
 }
 
+func TestToBytes(t *testing.T) {
+	uu := []struct {
+		v int64
+		e string
+	}{
+		{0, "0"},
+		{512, "512B"},
+		{2048, "2.0Ki"},
+		{3 * client.MegaByte / 2, "1.5Mi"},
+		{5 * 1024 * 1024 * 1024, "5.0Gi"},
+		{2048 * 1024 * 1024 * 1024, "2048.0Gi"},
+	}
+
+	for _, u := range uu {
+		assert.Equal(t, u.e, toBytes(u.v))
+	}
+}
+
+func TestToKiRate(t *testing.T) {
+	uu := []struct {
+		v float64
+		e string
+	}{
+		{0, "0"},
+		{2048, "2"},
+		{10 * 1024, "10"},
+	}
+
+	for _, u := range uu {
+		assert.Equal(t, u.e, toKiRate(u.v))
+	}
+}
+
+func TestToRate(t *testing.T) {
+	uu := []struct {
+		v float64
+		e string
+	}{
+		{0, "0"},
+		{0.25, "0.2"},
+		{3, "3.0"},
+	}
+
+	for _, u := range uu {
+		assert.Equal(t, u.e, toRate(u.v))
+	}
+}
+
 func TestIntToStr(t *testing.T) {
 	uu := []struct {
 		v int
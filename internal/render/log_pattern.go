@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// LogPattern renders a log lines cluster to screen.
+type LogPattern struct{}
+
+// IsGeneric identifies a generic handler.
+func (LogPattern) IsGeneric() bool {
+	return false
+}
+
+// Healthy checks if the resource is healthy.
+func (LogPattern) Healthy(context.Context, any) error {
+	return nil
+}
+
+// ColorerFunc colors a resource row.
+func (LogPattern) ColorerFunc() model1.ColorerFunc {
+	return model1.DefaultColorer
+}
+
+func (LogPattern) SetViewSetting(*config.ViewSetting) {}
+
+// Header returns a header row.
+func (LogPattern) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "PATTERN"},
+		model1.HeaderColumn{Name: "COUNT", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "FIRST SEEN", Attrs: model1.Attrs{Time: true}},
+		model1.HeaderColumn{Name: "LAST SEEN", Attrs: model1.Attrs{Time: true}},
+	}
+}
+
+// Render renders a log pattern to screen.
+func (LogPattern) Render(o any, _ string, r *model1.Row) error {
+	p, ok := o.(LogPatternRes)
+	if !ok {
+		return fmt.Errorf("expected LogPatternRes, but got %T", o)
+	}
+
+	r.ID = p.Template
+	r.Fields = append(r.Fields,
+		p.Template,
+		strconv.Itoa(p.Count),
+		ToAge(metav1.NewTime(p.FirstSeen)),
+		ToAge(metav1.NewTime(p.LastSeen)),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// LogPatternRes represents a cluster of log lines sharing the same masked template.
+type LogPatternRes struct {
+	Template            string
+	Count               int
+	FirstSeen, LastSeen time.Time
+	Lines               []string
+}
+
+// GetObjectKind returns a schema object.
+func (LogPatternRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p LogPatternRes) DeepCopyObject() runtime.Object {
+	return p
+}
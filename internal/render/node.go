@@ -104,6 +104,7 @@ func (n Node) Render(o any, _ string, row *model1.Row) error {
 	}
 
 	cols, err := n.specs.realize(nwm.Raw, defaultNOHeader, row)
+	cols.hydrateTrends(nwm.Trend)
 	cols.hydrateRow(row)
 
 	return err
@@ -222,6 +223,7 @@ type NodeWithMetrics struct {
 	Raw      *unstructured.Unstructured
 	MX       *mv1beta1.NodeMetrics
 	PodCount int
+	Trend    *Trend
 }
 
 // GetObjectKind returns a schema object.
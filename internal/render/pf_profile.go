@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// PFProfile renders port-forward profiles to screen.
+type PFProfile struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (PFProfile) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+		idx, ok := h.IndexOf("ACTIVE", true)
+		if !ok {
+			return c
+		}
+		active, total, _ := strings.Cut(re.Row.Fields[idx], "/")
+		switch {
+		case active == "0":
+			return model1.CompletedColor
+		case active != total:
+			return model1.PendingColor
+		default:
+			return tcell.ColorSkyblue
+		}
+	}
+}
+
+// Header returns a header row.
+func (PFProfile) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "AUTOSTART"},
+		model1.HeaderColumn{Name: "ACTIVE"},
+		model1.HeaderColumn{Name: "FORWARDS"},
+	}
+}
+
+// Render renders a port-forward profile to screen.
+func (PFProfile) Render(o any, _ string, r *model1.Row) error {
+	p, ok := o.(PFProfileRes)
+	if !ok {
+		return fmt.Errorf("expected PFProfileRes, but got %T", o)
+	}
+
+	ff := make([]string, 0, len(p.Profile.Forwards))
+	for _, f := range p.Profile.Forwards {
+		t := f.Tunnel("")
+		ff = append(ff, fmt.Sprintf("%s:%s[%s]", f.TargetGVR().R(), f.Path, t.PortMap()))
+	}
+	r.ID = p.Profile.Name
+	r.Fields = model1.Fields{
+		p.Profile.Name,
+		strconv.FormatBool(p.Profile.AutoStart),
+		strconv.Itoa(p.Active) + "/" + strconv.Itoa(len(p.Profile.Forwards)),
+		strings.Join(ff, ","),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// PFProfileRes represents a port-forward profile and its active forwards count.
+type PFProfileRes struct {
+	Profile config.PFProfile
+	Active  int
+}
+
+// GetObjectKind returns a schema object.
+func (PFProfileRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p PFProfileRes) DeepCopyObject() runtime.Object {
+	return p
+}
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	cfg "github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPFProfileRender(t *testing.T) {
+	p := render.PFProfileRes{
+		Profile: cfg.PFProfile{
+			Name:      "morning",
+			AutoStart: true,
+			Forwards: []cfg.PFProfileForward{
+				{GVR: "apps/v1/deployments", Path: "fred/blee", ContainerPort: "8080", LocalPort: "9090"},
+				{Path: "fred/zorg-0", ContainerPort: "5432"},
+			},
+		},
+		Active: 1,
+	}
+
+	var (
+		r  model1.Row
+		re render.PFProfile
+	)
+	require.NoError(t, re.Render(p, "", &r))
+	assert.Equal(t, "morning", r.ID)
+	assert.Equal(t, model1.Fields{"morning", "true", "1/2", "deployments:fred/blee[9090:8080],pods:fred/zorg-0[5432:5432]"}, r.Fields)
+	assert.Equal(t, model1.PendingColor, re.ColorerFunc()("", re.Header(""), &model1.RowEvent{Row: r}))
+}
@@ -55,6 +55,7 @@ var defaultPodHeader = model1.Header{
 	model1.HeaderColumn{Name: "NAMESPACE"},
 	model1.HeaderColumn{Name: "NAME"},
 	model1.HeaderColumn{Name: "VS", Attrs: model1.Attrs{VS: true}},
+	model1.HeaderColumn{Name: "VULN", Attrs: model1.Attrs{VS: true}},
 	model1.HeaderColumn{Name: "PF"},
 	model1.HeaderColumn{Name: "READY"},
 	model1.HeaderColumn{Name: "STATUS"},
@@ -75,6 +76,9 @@ var defaultPodHeader = model1.Header{
 	model1.HeaderColumn{Name: "NOMINATED NODE", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "READINESS GATES", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "QOS", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "NET-RX", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true, Wide: true}},
+	model1.HeaderColumn{Name: "NET-TX", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true, Wide: true}},
+	model1.HeaderColumn{Name: "RESTARTS/H", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true, Wide: true}},
 	model1.HeaderColumn{Name: "LABELS", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
@@ -141,6 +145,7 @@ func (p *Pod) Render(o any, _ string, row *model1.Row) error {
 		return nil
 	}
 	cols, err := p.specs.realize(pwm.Raw.DeepCopy(), defaultPodHeader, row)
+	cols.hydrateTrends(pwm.Trend)
 	cols.hydrateRow(row)
 
 	return err
@@ -173,12 +178,17 @@ func (p *Pod) defaultRow(pwm *PodWithMetrics, row *model1.Row) error {
 	phase := p.Phase(dt, spec, &st)
 
 	ns, n := pwm.Raw.GetNamespace(), pwm.Raw.GetName()
+	rx, tx, rr := NAValue, NAValue, NAValue
+	if pwm.XMX != nil {
+		rx, tx, rr = toKiRate(pwm.XMX.NetRx), toKiRate(pwm.XMX.NetTx), toRate(pwm.XMX.RestartsRate)
+	}
 
 	row.ID = client.FQN(ns, n)
 	row.Fields = model1.Fields{
 		ns,
 		n,
 		computeVulScore(ns, pwm.Raw.GetLabels(), spec),
+		computeVulVerdict(ns, pwm.Raw.GetLabels(), spec),
 		"●",
 		strconv.Itoa(cReady) + "/" + strconv.Itoa(allCounts),
 		phase,
@@ -199,6 +209,9 @@ func (p *Pod) defaultRow(pwm *PodWithMetrics, row *model1.Row) error {
 		asNominated(st.NominatedNodeName),
 		asReadinessGate(spec, &st),
 		p.mapQOS(st.QOSClass),
+		rx,
+		tx,
+		rr,
 		mapToStr(pwm.Raw.GetLabels()),
 		AsStatus(p.diagnose(phase, cReady, allCounts, ready, rgr, rgt)),
 		ToAge(pwm.Raw.GetCreationTimestamp()),
@@ -292,8 +305,10 @@ func asReadinessGate(spec *v1.PodSpec, st *v1.PodStatus) string {
 
 // PodWithMetrics represents a pod and its metrics.
 type PodWithMetrics struct {
-	Raw *unstructured.Unstructured
-	MX  *mv1beta1.PodMetrics
+	Raw   *unstructured.Unstructured
+	MX    *mv1beta1.PodMetrics
+	XMX   *client.PodExtMetrics
+	Trend *Trend
 }
 
 // GetObjectKind returns a schema object.
@@ -36,8 +36,28 @@ func TestPortForwardRender(t *testing.T) {
 		"http://0.0.0.0:p1/",
 		"1",
 		"1",
+		"n/a",
+		"0",
+		"0",
+		"0",
 		"",
-	}, r.Fields[:8])
+	}, r.Fields[:12])
+}
+
+func TestPortForwardRenderFollow(t *testing.T) {
+	o := render.ForwardRes{
+		Forwarder: followFwd{},
+	}
+
+	var p render.PortForward
+	var r model1.Row
+	require.NoError(t, p.Render(o, "fred", &r))
+	assert.Equal(t, model1.Fields{
+		"apps/v1/deployments:blee/fred",
+		"2",
+		"1.5Ki",
+		"200B",
+	}, r.Fields[7:11])
 }
 
 // Helpers...
@@ -71,3 +91,23 @@ func (fwd) Age() time.Time {
 func (fwd) Address() string {
 	return ""
 }
+
+type followFwd struct {
+	fwd
+}
+
+func (followFwd) Target() string {
+	return "apps/v1/deployments:blee/fred"
+}
+
+func (followFwd) Reconnects() int {
+	return 2
+}
+
+func (followFwd) BytesIn() int64 {
+	return 1536
+}
+
+func (followFwd) BytesOut() int64 {
+	return 200
+}
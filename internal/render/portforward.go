@@ -5,12 +5,14 @@ package render
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -37,6 +39,21 @@ type Forwarder interface {
 	Age() time.Time
 }
 
+// Follower represents a port forwarder that follows pod replacements.
+type Follower interface {
+	// Target returns the followed resource if any.
+	Target() string
+
+	// Reconnects returns the number of reconnections.
+	Reconnects() int
+
+	// BytesIn returns the number of bytes received.
+	BytesIn() int64
+
+	// BytesOut returns the number of bytes sent.
+	BytesOut() int64
+}
+
 // PortForward renders a portforwards to screen.
 type PortForward struct {
 	Base
@@ -59,6 +76,10 @@ func (PortForward) Header(string) model1.Header {
 		model1.HeaderColumn{Name: "URL"},
 		model1.HeaderColumn{Name: "C"},
 		model1.HeaderColumn{Name: "N"},
+		model1.HeaderColumn{Name: "FOLLOW"},
+		model1.HeaderColumn{Name: "RECONNECTS", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "IN", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "OUT", Attrs: model1.Attrs{Align: tview.AlignRight}},
 		model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
 		model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
 	}
@@ -74,6 +95,14 @@ func (PortForward) Render(o any, _ string, r *model1.Row) error {
 	ports := strings.Split(pf.Port(), ":")
 	r.ID = pf.ID()
 	ns, n := client.Namespaced(r.ID)
+	follow, reconnects, in, out := NAValue, ZeroValue, ZeroValue, ZeroValue
+	if f, ok := pf.Forwarder.(Follower); ok {
+		if t := f.Target(); t != "" {
+			follow = t
+		}
+		reconnects = strconv.Itoa(f.Reconnects())
+		in, out = toBytes(f.BytesIn()), toBytes(f.BytesOut())
+	}
 
 	r.Fields = model1.Fields{
 		ns,
@@ -83,6 +112,10 @@ func (PortForward) Render(o any, _ string, r *model1.Row) error {
 		UrlFor(pf.Config.Host, pf.Config.Path, ports[0], pf.Address()),
 		AsThousands(int64(pf.Config.C)),
 		AsThousands(int64(pf.Config.N)),
+		follow,
+		reconnects,
+		in,
+		out,
 		"",
 		ToAge(metav1.Time{Time: pf.Age()}),
 	}
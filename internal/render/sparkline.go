@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/model1"
+)
+
+// SparklineWidth represents the max number of samples rendered in a sparkline.
+const SparklineWidth = 10
+
+var sparkTicks = []rune("▁▂▃▄▅▆▇█")
+
+// Trend tracks a resource cpu and memory usage samples, oldest first.
+type Trend struct {
+	CPU, MEM []int64
+}
+
+// Sparkline renders the last width samples as a tiny trend chart.
+func Sparkline(vv []int64, width int) string {
+	if len(vv) == 0 {
+		return NAValue
+	}
+	if width > 0 && len(vv) > width {
+		vv = vv[len(vv)-width:]
+	}
+
+	lo, hi := vv[0], vv[0]
+	for _, v := range vv {
+		lo, hi = min(lo, v), max(hi, v)
+	}
+	var sb strings.Builder
+	sb.Grow(len(vv) * 3)
+	top := int64(len(sparkTicks) - 1)
+	for _, v := range vv {
+		var idx int64
+		if hi > lo {
+			idx = (v - lo) * top / (hi - lo)
+		}
+		sb.WriteRune(sparkTicks[idx])
+	}
+
+	return sb.String()
+}
+
+func isTrendCol(h model1.HeaderColumn) bool {
+	return h.TrendCPU || h.TrendMEM
+}
+
+func (rr RenderedCols) hydrateTrends(t *Trend) {
+	for i := range rr {
+		switch {
+		case t == nil:
+			continue
+		case rr[i].Header.TrendCPU:
+			rr[i].Value = Sparkline(t.CPU, SparklineWidth)
+		case rr[i].Header.TrendMEM:
+			rr[i].Value = Sparkline(t.MEM, SparklineWidth)
+		}
+	}
+}
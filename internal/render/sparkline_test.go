@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	cfg "github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSparkline(t *testing.T) {
+	uu := map[string]struct {
+		vv []int64
+		w  int
+		e  string
+	}{
+		"empty": {
+			e: render.NAValue,
+		},
+		"single": {
+			vv: []int64{10},
+			w:  5,
+			e:  "▁",
+		},
+		"flat": {
+			vv: []int64{5, 5, 5},
+			w:  5,
+			e:  "▁▁▁",
+		},
+		"climbing": {
+			vv: []int64{0, 1, 2, 3, 4, 5, 6, 7},
+			w:  10,
+			e:  "▁▂▃▄▅▆▇█",
+		},
+		"truncated": {
+			vv: []int64{100, 0, 10, 20},
+			w:  3,
+			e:  "▁▄█",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.Sparkline(u.vv, u.w))
+		})
+	}
+}
+
+func TestPodRenderTrend(t *testing.T) {
+	po := render.NewPod()
+	po.SetViewSetting(&cfg.ViewSetting{
+		Columns: []string{"NAME", "MEM-TREND|M", "CPU-TREND|C"},
+	})
+
+	pom := render.PodWithMetrics{
+		Raw:   load(t, "po"),
+		MX:    makePodMX("nginx", "100m", "50Mi"),
+		Trend: &render.Trend{CPU: []int64{1, 2}, MEM: []int64{4, 2, 0}},
+	}
+	var r model1.Row
+	require.NoError(t, po.Render(&pom, "", &r))
+	assert.Equal(t, model1.Fields{"nginx", "█▄▁", "▁█"}, r.Fields[:3])
+
+	h := po.Header("")
+	assert.True(t, h[1].TrendMEM)
+	assert.True(t, h[2].TrendCPU)
+	assert.True(t, h[2].MX)
+}
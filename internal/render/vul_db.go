@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// VulDBState represents the vulnerability database state property.
+	VulDBState = "State"
+
+	// VulDBError represents the vulnerability database load error property.
+	VulDBError = "Error"
+
+	// VulDBUpdateError represents the vulnerability database update error property.
+	VulDBUpdateError = "Update Error"
+
+	vulDBReady   = "READY"
+	vulDBLoading = "LOADING"
+	vulDBStale   = "STALE"
+)
+
+// VulDB renders the vulnerability database status to screen.
+type VulDB struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (VulDB) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+		if len(re.Row.Fields) < 2 {
+			return c
+		}
+
+		name, val := re.Row.Fields[0], strings.TrimSpace(re.Row.Fields[1])
+		switch name {
+		case VulDBState:
+			switch val {
+			case vulDBReady:
+				return model1.StdColor
+			case vulDBLoading:
+				return model1.PendingColor
+			case vulDBStale:
+				return tcell.ColorDarkOrange
+			default:
+				return model1.ErrColor
+			}
+		case VulDBError, VulDBUpdateError:
+			if val != "" {
+				return model1.ErrColor
+			}
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (VulDB) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "PROPERTY"},
+		model1.HeaderColumn{Name: "VALUE"},
+	}
+}
+
+// Render renders a vulnerability database status property to screen.
+func (VulDB) Render(o any, _ string, r *model1.Row) error {
+	p, ok := o.(VulDBRes)
+	if !ok {
+		return fmt.Errorf("expected VulDBRes, but got %T", o)
+	}
+
+	r.ID = p.Name
+	r.Fields = model1.Fields{
+		p.Name,
+		p.Value,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// VulDBRes represents a vulnerability database status property.
+type VulDBRes struct {
+	Name, Value string
+}
+
+// GetObjectKind returns a schema object.
+func (VulDBRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p VulDBRes) DeepCopyObject() runtime.Object {
+	return p
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tcell/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVulDBRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.VulDBRes
+		e   tcell.Color
+	}{
+		"ready": {
+			res: render.VulDBRes{Name: render.VulDBState, Value: "READY"},
+			e:   model1.StdColor,
+		},
+		"loading": {
+			res: render.VulDBRes{Name: render.VulDBState, Value: "LOADING"},
+			e:   model1.PendingColor,
+		},
+		"stale": {
+			res: render.VulDBRes{Name: render.VulDBState, Value: "STALE"},
+			e:   tcell.ColorDarkOrange,
+		},
+		"missing": {
+			res: render.VulDBRes{Name: render.VulDBState, Value: "MISSING"},
+			e:   model1.ErrColor,
+		},
+		"update-error": {
+			res: render.VulDBRes{Name: render.VulDBUpdateError, Value: "update failed: no route to host"},
+			e:   model1.ErrColor,
+		},
+		"no-error": {
+			res: render.VulDBRes{Name: render.VulDBError},
+			e:   model1.StdColor,
+		},
+	}
+
+	var re render.VulDB
+	h := re.Header("")
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, re.Render(u.res, "", &r))
+			assert.Equal(t, u.res.Name, r.ID)
+			assert.Equal(t, model1.Fields{u.res.Name, u.res.Value}, r.Fields)
+			assert.Equal(t, u.e, re.ColorerFunc()("", h, &model1.RowEvent{Row: r}))
+		})
+	}
+}
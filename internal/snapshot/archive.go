@@ -0,0 +1,410 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+const (
+	manifestFile  = "manifest.json"
+	groupsFile    = "discovery/groups.json"
+	resourcesFile = "discovery/resources.json"
+	resourcesDir  = "resources"
+	logsDir       = "logs"
+	coreGroup     = "core"
+	lastApplied   = "kubectl.kubernetes.io/last-applied-configuration"
+	redacted      = "<redacted>"
+	archiveMod    = 0o600
+)
+
+var secretGVR = schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+
+// Manifest describes the origin of a snapshot.
+type Manifest struct {
+	Context   string        `json:"context"`
+	Cluster   string        `json:"cluster"`
+	Namespace string        `json:"namespace,omitempty"`
+	CreatedAt time.Time     `json:"createdAt"`
+	Server    *version.Info `json:"server,omitempty"`
+}
+
+// Archive represents a point in time capture of a cluster.
+type Archive struct {
+	Manifest  Manifest
+	Groups    *metav1.APIGroupList
+	Resources []*metav1.APIResourceList
+
+	objects map[schema.GroupVersionResource]map[string]*unstructured.Unstructured
+	logs    map[string][]byte
+	mx      sync.RWMutex
+}
+
+// NewArchive returns a new empty snapshot archive.
+func NewArchive(m Manifest) *Archive {
+	return &Archive{
+		Manifest: m,
+		Groups:   &metav1.APIGroupList{},
+		objects:  make(map[schema.GroupVersionResource]map[string]*unstructured.Unstructured),
+		logs:     make(map[string][]byte),
+	}
+}
+
+// FileName returns the default archive file name for this snapshot.
+func (a *Archive) FileName() string {
+	return fmt.Sprintf("snapshot-%s-%d.tgz", a.Manifest.Context, a.Manifest.CreatedAt.Unix())
+}
+
+// LogKey returns a container logs key.
+func LogKey(ns, po, co string) string {
+	return path.Join(ns, po, co)
+}
+
+// Add records resource instances. Secret values are never archived.
+func (a *Archive) Add(gvr schema.GroupVersionResource, oo ...*unstructured.Unstructured) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	mm, ok := a.objects[gvr]
+	if !ok {
+		mm = make(map[string]*unstructured.Unstructured, len(oo))
+		a.objects[gvr] = mm
+	}
+	for _, o := range oo {
+		if gvr == secretGVR {
+			o = redactSecret(o)
+		}
+		mm[path.Join(o.GetNamespace(), o.GetName())] = o
+	}
+}
+
+// AddLogs records a container logs tail.
+func (a *Archive) AddLogs(ns, po, co string, bb []byte) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	a.logs[LogKey(ns, po, co)] = bb
+}
+
+// GVRs returns all archived resources.
+func (a *Archive) GVRs() []schema.GroupVersionResource {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	gg := make([]schema.GroupVersionResource, 0, len(a.objects))
+	for gvr := range a.objects {
+		gg = append(gg, gvr)
+	}
+	slices.SortFunc(gg, func(a, b schema.GroupVersionResource) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	return gg
+}
+
+// List returns all archived instances for a given resource sorted by path.
+func (a *Archive) List(gvr schema.GroupVersionResource) []*unstructured.Unstructured {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	mm := a.objects[gvr]
+	kk := make([]string, 0, len(mm))
+	for k := range mm {
+		kk = append(kk, k)
+	}
+	slices.Sort(kk)
+	oo := make([]*unstructured.Unstructured, 0, len(kk))
+	for _, k := range kk {
+		oo = append(oo, mm[k])
+	}
+
+	return oo
+}
+
+// Get returns an archived resource instance if present.
+func (a *Archive) Get(gvr schema.GroupVersionResource, ns, n string) (*unstructured.Unstructured, bool) {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	o, ok := a.objects[gvr][path.Join(ns, n)]
+
+	return o, ok
+}
+
+// Logs returns an archived container logs tail if present.
+func (a *Archive) Logs(ns, po, co string) ([]byte, bool) {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	bb, ok := a.logs[LogKey(ns, po, co)]
+
+	return bb, ok
+}
+
+// LogContainers returns all containers with archived logs for a given pod.
+func (a *Archive) LogContainers(ns, po string) []string {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	prefix := path.Join(ns, po) + "/"
+	var cc []string
+	for k := range a.logs {
+		if co, ok := strings.CutPrefix(k, prefix); ok {
+			cc = append(cc, co)
+		}
+	}
+	slices.Sort(cc)
+
+	return cc
+}
+
+// ResourceFor returns discovery information for a given resource.
+func (a *Archive) ResourceFor(gvr schema.GroupVersionResource) (metav1.APIResource, bool) {
+	gv := gvr.GroupVersion().String()
+	for _, l := range a.Resources {
+		if l.GroupVersion != gv {
+			continue
+		}
+		for _, r := range l.APIResources {
+			if r.Name == gvr.Resource {
+				return r, true
+			}
+		}
+	}
+
+	return metav1.APIResource{}, false
+}
+
+// Save writes the archive to a gzipped tarball.
+func (a *Archive) Save(fPath string) error {
+	f, err := os.OpenFile(fPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, archiveMod)
+	if err != nil {
+		return err
+	}
+	if err := a.Write(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// Write streams the archive as a gzipped tarball.
+func (a *Archive) Write(w io.Writer) error {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	if err := writeJSON(tw, manifestFile, a.Manifest, a.Manifest.CreatedAt); err != nil {
+		return err
+	}
+	if err := writeJSON(tw, groupsFile, a.Groups, a.Manifest.CreatedAt); err != nil {
+		return err
+	}
+	if err := writeJSON(tw, resourcesFile, a.Resources, a.Manifest.CreatedAt); err != nil {
+		return err
+	}
+	for gvr, mm := range a.objects {
+		oo := make([]*unstructured.Unstructured, 0, len(mm))
+		for _, o := range mm {
+			oo = append(oo, o)
+		}
+		if err := writeJSON(tw, resourcePath(gvr), oo, a.Manifest.CreatedAt); err != nil {
+			return err
+		}
+	}
+	for k, bb := range a.logs {
+		if err := writeEntry(tw, path.Join(logsDir, k+".log"), bb, a.Manifest.CreatedAt); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	return gz.Close()
+}
+
+// Load reads a snapshot archive from disk.
+func Load(fPath string) (*Archive, error) {
+	f, err := os.Open(fPath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	a, err := Read(f)
+	if err != nil {
+		return nil, fmt.Errorf("invalid snapshot %q: %w", fPath, err)
+	}
+
+	return a, nil
+}
+
+// Read decodes a gzipped tarball snapshot archive.
+func Read(r io.Reader) (*Archive, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = gz.Close() }()
+
+	var (
+		a           = NewArchive(Manifest{})
+		tr          = tar.NewReader(gz)
+		hasManifest bool
+	)
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if h.Typeflag != tar.TypeReg {
+			continue
+		}
+		switch name := path.Clean(h.Name); {
+		case name == manifestFile:
+			hasManifest = true
+			err = json.NewDecoder(tr).Decode(&a.Manifest)
+		case name == groupsFile:
+			err = json.NewDecoder(tr).Decode(a.Groups)
+		case name == resourcesFile:
+			err = json.NewDecoder(tr).Decode(&a.Resources)
+		case strings.HasPrefix(name, resourcesDir+"/"):
+			err = a.readResources(name, tr)
+		case strings.HasPrefix(name, logsDir+"/"):
+			err = a.readLogs(name, tr)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", h.Name, err)
+		}
+	}
+	if !hasManifest {
+		return nil, errors.New("no snapshot manifest found")
+	}
+
+	return a, nil
+}
+
+func (a *Archive) readResources(name string, r io.Reader) error {
+	gvr, ok := parseResourcePath(name)
+	if !ok {
+		return errors.New("invalid resource path")
+	}
+	var oo []*unstructured.Unstructured
+	if err := json.NewDecoder(r).Decode(&oo); err != nil {
+		return err
+	}
+	a.Add(gvr, oo...)
+
+	return nil
+}
+
+func (a *Archive) readLogs(name string, r io.Reader) error {
+	tokens := strings.Split(strings.TrimSuffix(strings.TrimPrefix(name, logsDir+"/"), ".log"), "/")
+	if len(tokens) != 3 {
+		return errors.New("invalid logs path")
+	}
+	bb, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	a.AddLogs(tokens[0], tokens[1], tokens[2], bb)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func resourcePath(gvr schema.GroupVersionResource) string {
+	g := gvr.Group
+	if g == "" {
+		g = coreGroup
+	}
+
+	return path.Join(resourcesDir, g, gvr.Version, gvr.Resource+".json")
+}
+
+func parseResourcePath(name string) (schema.GroupVersionResource, bool) {
+	tokens := strings.Split(strings.TrimPrefix(name, resourcesDir+"/"), "/")
+	if len(tokens) != 3 || !strings.HasSuffix(tokens[2], ".json") {
+		return schema.GroupVersionResource{}, false
+	}
+	g := tokens[0]
+	if g == coreGroup {
+		g = ""
+	}
+
+	return schema.GroupVersionResource{
+		Group:    g,
+		Version:  tokens[1],
+		Resource: strings.TrimSuffix(tokens[2], ".json"),
+	}, true
+}
+
+func redactSecret(o *unstructured.Unstructured) *unstructured.Unstructured {
+	o = o.DeepCopy()
+	for _, k := range []string{"data", "stringData"} {
+		mm, ok := o.Object[k].(map[string]any)
+		if !ok {
+			continue
+		}
+		for kk := range mm {
+			mm[kk] = ""
+		}
+	}
+	if aa := o.GetAnnotations(); aa != nil {
+		if _, ok := aa[lastApplied]; ok {
+			aa[lastApplied] = redacted
+			o.SetAnnotations(aa)
+		}
+	}
+
+	return o
+}
+
+func writeJSON(tw *tar.Writer, name string, v any, t time.Time) error {
+	bb, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return writeEntry(tw, name, bb, t)
+}
+
+func writeEntry(tw *tar.Writer, name string, bb []byte, t time.Time) error {
+	h := tar.Header{
+		Name:    name,
+		Mode:    archiveMod,
+		Size:    int64(len(bb)),
+		ModTime: t,
+	}
+	if err := tw.WriteHeader(&h); err != nil {
+		return err
+	}
+	_, err := tw.Write(bb)
+
+	return err
+}
@@ -0,0 +1,483 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package snapshot
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/slogs"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	jsonContent  = "application/json"
+	textContent  = "text/plain"
+	tableMarker  = "as=Table"
+	initEventsRV = "k8s.io/initial-events-end"
+	sarPath      = "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews"
+)
+
+var readVerbs = sets.New("get", "list", "watch")
+
+// Server serves a snapshot archive as a read-only Kubernetes api server.
+type Server struct {
+	archive *Archive
+	srv     *http.Server
+	addr    string
+}
+
+// NewServer returns a new snapshot api server.
+func NewServer(a *Archive) *Server {
+	s := Server{archive: a}
+	s.srv = &http.Server{
+		Handler:           &s,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	return &s
+}
+
+// Start starts serving the archive on a local ephemeral port.
+func (s *Server) Start() (string, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	s.addr = "http://" + l.Addr().String()
+	go func() {
+		if err := s.srv.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("Snapshot server failed", slogs.Error, err)
+		}
+	}()
+
+	return s.addr, nil
+}
+
+// Stop terminates the server.
+func (s *Server) Stop() error {
+	return s.srv.Close()
+}
+
+// WriteKubeConfig writes a kubeconfig pointing to this server.
+func (s *Server) WriteKubeConfig(fPath string) error {
+	m := s.archive.Manifest
+	cfg := api.NewConfig()
+	cfg.Clusters[m.Cluster] = &api.Cluster{Server: s.addr}
+	cfg.AuthInfos[m.Context] = &api.AuthInfo{}
+	cfg.Contexts[m.Context] = &api.Context{
+		Cluster:   m.Cluster,
+		AuthInfo:  m.Context,
+		Namespace: m.Namespace,
+	}
+	cfg.CurrentContext = m.Context
+
+	return clientcmd.WriteToFile(*cfg, fPath)
+}
+
+// ServeHTTP serves api requests from the archive.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p := path.Clean(r.URL.Path)
+	if r.Method == http.MethodPost && p == sarPath {
+		s.accessReview(w, r)
+		return
+	}
+	if r.Method != http.MethodGet {
+		writeStatus(w, apierrors.NewMethodNotSupported(schema.GroupResource{Resource: p}, "snapshot is read-only"))
+		return
+	}
+
+	switch p {
+	case "/version":
+		info := s.archive.Manifest.Server
+		if info == nil {
+			info = &version.Info{}
+		}
+		writeResponse(w, http.StatusOK, info)
+		return
+	case "/api":
+		writeResponse(w, http.StatusOK, &metav1.APIVersions{
+			TypeMeta: metav1.TypeMeta{Kind: "APIVersions"},
+			Versions: []string{"v1"},
+		})
+		return
+	case "/apis":
+		writeResponse(w, http.StatusOK, s.groups())
+		return
+	}
+
+	req, ok := parseRequest(p)
+	if !ok {
+		writeStatus(w, apierrors.NewNotFound(schema.GroupResource{}, p))
+		return
+	}
+	if req.gvr.Resource == "" {
+		s.resourceList(w, req.gvr.GroupVersion())
+		return
+	}
+	s.serveResource(w, r, req)
+}
+
+func (s *Server) groups() *metav1.APIGroupList {
+	gg := metav1.APIGroupList{TypeMeta: metav1.TypeMeta{Kind: "APIGroupList", APIVersion: "v1"}}
+	for _, g := range s.archive.Groups.Groups {
+		if g.Name != "" {
+			gg.Groups = append(gg.Groups, g)
+		}
+	}
+
+	return &gg
+}
+
+func (s *Server) resourceList(w http.ResponseWriter, gv schema.GroupVersion) {
+	for _, l := range s.archive.Resources {
+		if l.GroupVersion == gv.String() {
+			rl := *l
+			rl.TypeMeta = metav1.TypeMeta{Kind: "APIResourceList", APIVersion: "v1"}
+			writeResponse(w, http.StatusOK, &rl)
+			return
+		}
+	}
+	writeStatus(w, apierrors.NewNotFound(schema.GroupResource{Group: gv.Group}, gv.Version))
+}
+
+func (s *Server) accessReview(w http.ResponseWriter, r *http.Request) {
+	var sar authorizationv1.SelfSubjectAccessReview
+	bb, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeStatus(w, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	if _, _, err := scheme.Codecs.UniversalDeserializer().Decode(bb, nil, &sar); err != nil {
+		writeStatus(w, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	sar.APIVersion, sar.Kind = authorizationv1.SchemeGroupVersion.String(), "SelfSubjectAccessReview"
+	if attrs := sar.Spec.ResourceAttributes; attrs != nil && readVerbs.Has(attrs.Verb) {
+		sar.Status.Allowed = true
+	} else {
+		sar.Status.Reason = "snapshot is read-only"
+	}
+	writeResponse(w, http.StatusCreated, &sar)
+}
+
+func (s *Server) serveResource(w http.ResponseWriter, r *http.Request, req request) {
+	gr := req.gvr.GroupResource()
+	switch {
+	case req.sub == "log" && req.gvr.Resource == "pods":
+		s.logs(w, r, req)
+		return
+	case req.sub != "" && req.sub != "status":
+		writeStatus(w, apierrors.NewNotFound(gr, req.name+"/"+req.sub))
+		return
+	}
+
+	if req.name != "" {
+		o, ok := s.archive.Get(req.gvr, req.ns, req.name)
+		if !ok {
+			writeStatus(w, apierrors.NewNotFound(gr, req.name))
+			return
+		}
+		if isTable(r) {
+			writeResponse(w, http.StatusOK, s.toTable(r, []*unstructured.Unstructured{o}))
+			return
+		}
+		writeResponse(w, http.StatusOK, o)
+		return
+	}
+
+	oo, err := s.list(r, req)
+	if err != nil {
+		writeStatus(w, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	q := r.URL.Query()
+	if isTrue(q.Get("watch")) {
+		s.watch(w, r, req, oo, isTrue(q.Get("sendInitialEvents")))
+		return
+	}
+	if isTable(r) {
+		writeResponse(w, http.StatusOK, s.toTable(r, oo))
+		return
+	}
+
+	kind := "List"
+	if res, ok := s.archive.ResourceFor(req.gvr); ok {
+		kind = res.Kind + "List"
+	}
+	items := make([]any, 0, len(oo))
+	for _, o := range oo {
+		items = append(items, o.Object)
+	}
+	writeResponse(w, http.StatusOK, map[string]any{
+		"apiVersion": req.gvr.GroupVersion().String(),
+		"kind":       kind,
+		"metadata":   map[string]any{"resourceVersion": s.resourceVersion(req.gvr)},
+		"items":      items,
+	})
+}
+
+func (s *Server) list(r *http.Request, req request) ([]*unstructured.Unstructured, error) {
+	q := r.URL.Query()
+	lsel, err := labels.Parse(q.Get("labelSelector"))
+	if err != nil {
+		return nil, err
+	}
+	fsel, err := fields.ParseSelector(q.Get("fieldSelector"))
+	if err != nil {
+		return nil, err
+	}
+
+	var oo []*unstructured.Unstructured
+	for _, o := range s.archive.List(req.gvr) {
+		if req.ns != "" && o.GetNamespace() != req.ns {
+			continue
+		}
+		if !lsel.Matches(labels.Set(o.GetLabels())) || !matchFields(o, fsel) {
+			continue
+		}
+		oo = append(oo, o)
+	}
+
+	return oo, nil
+}
+
+func (s *Server) watch(w http.ResponseWriter, r *http.Request, req request, oo []*unstructured.Unstructured, initEvents bool) {
+	w.Header().Set("Content-Type", jsonContent)
+	w.WriteHeader(http.StatusOK)
+	enc := json.NewEncoder(w)
+	if initEvents {
+		for _, o := range oo {
+			_ = enc.Encode(map[string]any{"type": watch.Added, "object": o.Object})
+		}
+		var b unstructured.Unstructured
+		if res, ok := s.archive.ResourceFor(req.gvr); ok {
+			b.SetKind(res.Kind)
+		}
+		b.SetAPIVersion(req.gvr.GroupVersion().String())
+		b.SetResourceVersion(s.resourceVersion(req.gvr))
+		b.SetAnnotations(map[string]string{initEventsRV: "true"})
+		_ = enc.Encode(map[string]any{"type": watch.Bookmark, "object": b.Object})
+	}
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+
+	// Snapshots never change, just hang on until the client bails out.
+	<-r.Context().Done()
+}
+
+func (s *Server) logs(w http.ResponseWriter, r *http.Request, req request) {
+	q := r.URL.Query()
+	co := q.Get("container")
+	if co == "" {
+		if cc := s.archive.LogContainers(req.ns, req.name); len(cc) > 0 {
+			co = cc[0]
+		}
+	}
+	bb, ok := s.archive.Logs(req.ns, req.name, co)
+	if !ok {
+		writeStatus(w, apierrors.NewNotFound(schema.GroupResource{Resource: "pods/log"}, path.Join(req.name, co)))
+		return
+	}
+
+	lines := bytes.SplitAfter(bb, []byte("\n"))
+	if len(lines) > 0 && len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+	if n, err := strconv.Atoi(q.Get("tailLines")); err == nil && n >= 0 && n < len(lines) {
+		lines = lines[len(lines)-n:]
+	}
+	withTS := isTrue(q.Get("timestamps"))
+
+	w.Header().Set("Content-Type", textContent)
+	w.WriteHeader(http.StatusOK)
+	for _, l := range lines {
+		if !withTS {
+			if _, after, ok := bytes.Cut(l, []byte(" ")); ok {
+				l = after
+			}
+		}
+		_, _ = w.Write(l)
+	}
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+	if isTrue(q.Get("follow")) {
+		<-r.Context().Done()
+	}
+}
+
+func (s *Server) toTable(r *http.Request, oo []*unstructured.Unstructured) *metav1.Table {
+	t := metav1.Table{
+		TypeMeta: metav1.TypeMeta{Kind: "Table", APIVersion: metav1.SchemeGroupVersion.String()},
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name"},
+			{Name: "Age", Type: "string"},
+		},
+		Rows: make([]metav1.TableRow, 0, len(oo)),
+	}
+	include := r.URL.Query().Get("includeObject")
+	for _, o := range oo {
+		row := metav1.TableRow{
+			Cells: []any{o.GetName(), age(o.GetCreationTimestamp())},
+		}
+		switch include {
+		case "None":
+		case "Object":
+			row.Object.Raw, _ = o.MarshalJSON()
+		default:
+			row.Object.Raw, _ = json.Marshal(&metav1.PartialObjectMetadata{
+				TypeMeta:   metav1.TypeMeta{Kind: "PartialObjectMetadata", APIVersion: metav1.SchemeGroupVersion.String()},
+				ObjectMeta: objectMeta(o),
+			})
+		}
+		t.Rows = append(t.Rows, row)
+	}
+
+	return &t
+}
+
+func (s *Server) resourceVersion(gvr schema.GroupVersionResource) string {
+	var rv uint64 = 1
+	for _, o := range s.archive.List(gvr) {
+		if v, err := strconv.ParseUint(o.GetResourceVersion(), 10, 64); err == nil && v > rv {
+			rv = v
+		}
+	}
+
+	return strconv.FormatUint(rv, 10)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type request struct {
+	gvr           schema.GroupVersionResource
+	ns, name, sub string
+}
+
+// parseRequest grok api paths ie /api/v1/namespaces/ns/pods/name/log.
+func parseRequest(p string) (request, bool) {
+	var (
+		req    request
+		tokens = strings.Split(strings.Trim(p, "/"), "/")
+	)
+	switch {
+	case len(tokens) >= 2 && tokens[0] == "api":
+		req.gvr.Version, tokens = tokens[1], tokens[2:]
+	case len(tokens) >= 3 && tokens[0] == "apis":
+		req.gvr.Group, req.gvr.Version, tokens = tokens[1], tokens[2], tokens[3:]
+	default:
+		return req, false
+	}
+	if len(tokens) >= 3 && tokens[0] == "namespaces" {
+		req.ns, tokens = tokens[1], tokens[2:]
+	}
+	switch len(tokens) {
+	case 0:
+	case 1:
+		req.gvr.Resource = tokens[0]
+	case 2:
+		req.gvr.Resource, req.name = tokens[0], tokens[1]
+	case 3:
+		req.gvr.Resource, req.name, req.sub = tokens[0], tokens[1], tokens[2]
+	default:
+		return req, false
+	}
+
+	return req, true
+}
+
+func matchFields(o *unstructured.Unstructured, sel fields.Selector) bool {
+	if sel.Empty() {
+		return true
+	}
+	for _, r := range sel.Requirements() {
+		v, _, _ := unstructured.NestedFieldNoCopy(o.Object, strings.Split(r.Field, ".")...)
+		val := ""
+		if v != nil {
+			val = fmt.Sprintf("%v", v)
+		}
+		switch r.Operator {
+		case "!=":
+			if val == r.Value {
+				return false
+			}
+		default:
+			if val != r.Value {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func objectMeta(o *unstructured.Unstructured) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:              o.GetName(),
+		Namespace:         o.GetNamespace(),
+		UID:               o.GetUID(),
+		ResourceVersion:   o.GetResourceVersion(),
+		CreationTimestamp: o.GetCreationTimestamp(),
+		Labels:            o.GetLabels(),
+		Annotations:       o.GetAnnotations(),
+		OwnerReferences:   o.GetOwnerReferences(),
+	}
+}
+
+func age(t metav1.Time) string {
+	if t.IsZero() {
+		return "<unknown>"
+	}
+
+	return duration.HumanDuration(time.Since(t.Time))
+}
+
+func isTable(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), tableMarker)
+}
+
+func isTrue(s string) bool {
+	b, _ := strconv.ParseBool(s)
+	return b
+}
+
+func writeStatus(w http.ResponseWriter, err *apierrors.StatusError) {
+	st := err.Status()
+	st.TypeMeta = metav1.TypeMeta{Kind: "Status", APIVersion: "v1"}
+	writeResponse(w, int(st.Code), &st)
+}
+
+func writeResponse(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", jsonContent)
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.Warn("Snapshot response encoding failed", slogs.Error, err)
+	}
+}
@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package snapshot_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/snapshot"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+var podGVR = schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+
+func TestArchiveRoundTrip(t *testing.T) {
+	a := makeArchive()
+	a.Add(schema.GroupVersionResource{Version: "v1", Resource: "secrets"}, makeObj("Secret", "default", "s1", map[string]any{
+		"data": map[string]any{"password": "c2VjcmV0"},
+	}))
+
+	var buff bytes.Buffer
+	require.NoError(t, a.Write(&buff))
+	a1, err := snapshot.Read(&buff)
+	require.NoError(t, err)
+
+	assert.Equal(t, "fred", a1.Manifest.Context)
+	assert.Equal(t, "v1.33.0", a1.Manifest.Server.GitVersion)
+	assert.Len(t, a1.List(podGVR), 2)
+	bb, ok := a1.Logs("default", "p1", "c1")
+	assert.True(t, ok)
+	assert.Contains(t, string(bb), "hello")
+	assert.Equal(t, []string{"c1"}, a1.LogContainers("default", "p1"))
+
+	s, ok := a1.Get(schema.GroupVersionResource{Version: "v1", Resource: "secrets"}, "default", "s1")
+	assert.True(t, ok)
+	pwd, _, _ := unstructured.NestedString(s.Object, "data", "password")
+	assert.Empty(t, pwd)
+}
+
+func TestArchiveReadInvalid(t *testing.T) {
+	_, err := snapshot.Read(bytes.NewBufferString("blee"))
+	assert.Error(t, err)
+}
+
+func TestServer(t *testing.T) {
+	srv := snapshot.NewServer(makeArchive())
+	addr, err := srv.Start()
+	require.NoError(t, err)
+	defer func() { _ = srv.Stop() }()
+
+	cfg := rest.Config{Host: addr}
+	clt, err := kubernetes.NewForConfig(&cfg)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	info, err := clt.Discovery().ServerVersion()
+	require.NoError(t, err)
+	assert.Equal(t, "v1.33.0", info.GitVersion)
+
+	pp, err := clt.CoreV1().Pods("default").List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, pp.Items, 1)
+
+	pp, err = clt.CoreV1().Pods("").List(ctx, metav1.ListOptions{LabelSelector: "app=blee"})
+	require.NoError(t, err)
+	assert.Len(t, pp.Items, 1)
+	assert.Equal(t, "p2", pp.Items[0].Name)
+
+	pp, err = clt.CoreV1().Pods("").List(ctx, metav1.ListOptions{FieldSelector: "spec.nodeName=n1"})
+	require.NoError(t, err)
+	assert.Len(t, pp.Items, 1)
+	assert.Equal(t, "p1", pp.Items[0].Name)
+
+	po, err := clt.CoreV1().Pods("default").Get(ctx, "p1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "p1", po.Name)
+
+	_, err = clt.CoreV1().Pods("default").Get(ctx, "zorg", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	err = clt.CoreV1().Pods("default").Delete(ctx, "p1", metav1.DeleteOptions{})
+	assert.True(t, apierrors.IsMethodNotSupported(err))
+
+	tail := int64(1)
+	bb, err := clt.CoreV1().Pods("default").GetLogs("p1", &v1.PodLogOptions{TailLines: &tail}).Do(ctx).Raw()
+	require.NoError(t, err)
+	assert.Equal(t, "world\n", string(bb))
+
+	for verb, e := range map[string]bool{"list": true, "delete": false} {
+		sar, err := clt.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{Verb: verb, Resource: "pods"},
+			},
+		}, metav1.CreateOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, e, sar.Status.Allowed, verb)
+	}
+}
+
+func TestServerInformer(t *testing.T) {
+	srv := snapshot.NewServer(makeArchive())
+	addr, err := srv.Start()
+	require.NoError(t, err)
+	defer func() { _ = srv.Stop() }()
+
+	dial, err := dynamic.NewForConfig(&rest.Config{Host: addr})
+	require.NoError(t, err)
+	fac := dynamicinformer.NewDynamicSharedInformerFactory(dial, 0)
+	inf := fac.ForResource(podGVR)
+	stop := make(chan struct{})
+	defer close(stop)
+	fac.Start(stop)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	fac.WaitForCacheSync(ctx.Done())
+	require.True(t, inf.Informer().HasSynced())
+
+	oo, err := inf.Lister().List(labels.Everything())
+	require.NoError(t, err)
+	assert.Len(t, oo, 2)
+}
+
+// Helpers...
+
+func makeArchive() *snapshot.Archive {
+	a := snapshot.NewArchive(snapshot.Manifest{
+		Context:   "fred",
+		Cluster:   "zorg",
+		CreatedAt: time.Now(),
+		Server:    &version.Info{GitVersion: "v1.33.0"},
+	})
+	a.Groups.Groups = append(a.Groups.Groups, metav1.APIGroup{Name: ""})
+	a.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"get", "list", "watch"}},
+				{Name: "secrets", Kind: "Secret", Namespaced: true, Verbs: []string{"get", "list", "watch"}},
+			},
+		},
+	}
+	a.Add(podGVR,
+		makeObj("Pod", "default", "p1", map[string]any{"spec": map[string]any{"nodeName": "n1"}}),
+		makeObj("Pod", "blee", "p2", map[string]any{"metadata": map[string]any{"labels": map[string]any{"app": "blee"}}}),
+	)
+	a.AddLogs("default", "p1", "c1", []byte("2025-01-01T00:00:00Z hello\n2025-01-01T00:00:01Z world\n"))
+
+	return a
+}
+
+func makeObj(kind, ns, n string, extras map[string]any) *unstructured.Unstructured {
+	o := unstructured.Unstructured{Object: extras}
+	if o.Object == nil {
+		o.Object = make(map[string]any)
+	}
+	o.SetAPIVersion("v1")
+	o.SetKind(kind)
+	o.SetNamespace(ns)
+	o.SetName(n)
+	o.SetResourceVersion("10")
+
+	return &o
+}
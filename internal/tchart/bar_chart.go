@@ -0,0 +1,138 @@
+package tchart
+
+import (
+	"fmt"
+	"image"
+	"math"
+
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+// BarChart represents a grouped vertical bar chart component.
+type BarChart struct {
+	*Component
+
+	labels []string
+	series [][]float64
+	unit   string
+}
+
+// NewBarChart returns a new bar chart.
+func NewBarChart(id, unit string) *BarChart {
+	return &BarChart{
+		Component: NewComponent(id),
+		unit:      unit,
+	}
+}
+
+// SetLabels sets the bar groups labels.
+func (b *BarChart) SetLabels(ll ...string) {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+	b.labels = ll
+}
+
+// AddSeries adds a series of values, one per label.
+func (b *BarChart) AddSeries(vv ...float64) {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+	b.series = append(b.series, vv)
+}
+
+// Max returns the largest value across all series.
+func (b *BarChart) Max() float64 {
+	b.mx.RLock()
+	defer b.mx.RUnlock()
+
+	var m float64
+	for _, s := range b.series {
+		for _, v := range s {
+			m = math.Max(m, v)
+		}
+	}
+
+	return m
+}
+
+// Draw draws the chart.
+func (b *BarChart) Draw(screen tcell.Screen) {
+	b.Component.Draw(screen)
+	maxV := b.Max()
+
+	b.mx.RLock()
+	defer b.mx.RUnlock()
+
+	rect := b.asRect()
+	pad := 2
+	if b.legend != "" {
+		pad++
+	}
+	if len(b.labels) == 0 || rect.Dx() <= 2 || rect.Dy() <= pad {
+		return
+	}
+
+	axisStyle := tcell.StyleDefault.Foreground(tcell.GetColor(axisColor)).Background(b.bgColor)
+	baseY := rect.Max.Y - pad
+	for y := rect.Min.Y; y < baseY; y++ {
+		screen.SetContent(rect.Min.X, y, tview.BoxDrawingsLightVertical, nil, axisStyle)
+	}
+	screen.SetContent(rect.Min.X, baseY, tview.BoxDrawingsLightUpAndRight, nil, axisStyle)
+	for x := rect.Min.X + 1; x < rect.Max.X; x++ {
+		screen.SetContent(x, baseY, tview.BoxDrawingsLightHorizontal, nil, axisStyle)
+	}
+	tview.Print(screen, fmt.Sprintf("%.4g%s", maxV, b.unit), rect.Min.X+1, rect.Min.Y, rect.Dx()-1, tview.AlignLeft, tcell.ColorOrange)
+
+	groupW, barW := b.layout(rect.Dx() - 1)
+	scale := float64(len(sparks)*(baseY-rect.Min.Y-1)) / maxV
+	colors := b.colorForSeries()
+	for i, l := range b.labels {
+		x := rect.Min.X + 1 + i*groupW
+		for j, s := range b.series {
+			if i >= len(s) || maxV == 0 {
+				continue
+			}
+			blk := makeBlock(s[i], scale)
+			c := colors[j%len(colors)]
+			for w := range barW {
+				b.drawBar(screen, rect, x+j*barW+w, baseY-1, blk, c)
+			}
+		}
+		tview.Print(screen, l, x, baseY+1, groupW, tview.AlignCenter, tcell.ColorOrange)
+	}
+
+	if b.legend != "" {
+		legend := b.legend
+		if b.HasFocus() {
+			legend = fmt.Sprintf("[%s:%s:]", b.focusFgColor, b.focusBgColor) + b.legend + "[::]"
+		}
+		tview.Print(screen, legend, rect.Min.X, rect.Max.Y-1, rect.Dx(), tview.AlignCenter, tcell.ColorWhite)
+	}
+}
+
+// layout computes the group and bar width for a given chart width.
+func (b *BarChart) layout(width int) (groupW, barW int) {
+	groupW = width / len(b.labels)
+	n := max(len(b.series), 1)
+	barW = max((groupW-1)/n, 1)
+
+	return groupW, barW
+}
+
+func (b *BarChart) drawBar(screen tcell.Screen, r image.Rectangle, x, y int, blk block, c tcell.Color) {
+	if x >= r.Max.X {
+		return
+	}
+	style := tcell.StyleDefault.Foreground(c).Background(b.bgColor)
+	full := sparks[len(sparks)-1]
+	for range blk.full {
+		if y < r.Min.Y+1 {
+			return
+		}
+		screen.SetContent(x, y, full, nil, style)
+		y--
+	}
+	if blk.partial != 0 && y >= r.Min.Y+1 {
+		screen.SetContent(x, y, blk.partial, nil, style)
+	}
+}
@@ -0,0 +1,83 @@
+package tchart
+
+import (
+	"testing"
+
+	"github.com/derailed/tcell/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBarChartLayout(t *testing.T) {
+	uu := map[string]struct {
+		labels       []string
+		series       int
+		width        int
+		groupW, barW int
+	}{
+		"single": {
+			labels: []string{"a", "b"},
+			series: 1,
+			width:  20,
+			groupW: 10,
+			barW:   9,
+		},
+		"dual": {
+			labels: []string{"a", "b", "c"},
+			series: 2,
+			width:  30,
+			groupW: 10,
+			barW:   4,
+		},
+		"narrow": {
+			labels: []string{"a", "b", "c"},
+			series: 2,
+			width:  3,
+			groupW: 1,
+			barW:   1,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			b := NewBarChart("fred", "s")
+			b.SetLabels(u.labels...)
+			for range u.series {
+				b.AddSeries(1)
+			}
+			g, w := b.layout(u.width)
+			assert.Equal(t, u.groupW, g)
+			assert.Equal(t, u.barW, w)
+		})
+	}
+}
+
+func TestBarChartDraw(t *testing.T) {
+	sc := tcell.NewSimulationScreen("")
+	require.NoError(t, sc.Init())
+	sc.SetSize(20, 10)
+
+	b := NewBarChart("fred", "s")
+	b.SetRect(0, 0, 20, 10)
+	b.SetLabels("p50", "p99")
+	b.AddSeries(1, 2)
+	b.SetLegend("Latency")
+	assert.InDelta(t, 2.0, b.Max(), 0.001)
+	b.Draw(sc)
+	sc.Show()
+
+	cells, w, _ := sc.GetContents()
+	row := func(y int) string {
+		var s string
+		for x := range w {
+			s += string(cells[y*w+x].Runes)
+		}
+		return s
+	}
+	assert.Contains(t, row(0), "2s")
+	assert.Contains(t, row(8), "p50")
+	assert.Contains(t, row(8), "p99")
+	assert.Contains(t, row(9), "Latency")
+	assert.Contains(t, row(6), "█")
+}
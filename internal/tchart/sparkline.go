@@ -141,7 +141,7 @@ func (s *SparkLine) Draw(screen tcell.Screen) {
 	colors := s.colorForSeries()
 	cY := rect.Max.Y - pad - 1
 	for _, t := range s.series.Keys() {
-		b := s.makeBlock(s.series[t], scale)
+		b := makeBlock(s.series[t], scale)
 		s.drawBlock(rect, screen, cX, cY, b, colors[s.colorIndex%len(colors)])
 		cX++
 	}
@@ -182,7 +182,7 @@ func (s *SparkLine) cutSet(width int) {
 	}
 }
 
-func (*SparkLine) makeBlock(v, scale float64) block {
+func makeBlock(v, scale float64) block {
 	sc := (v * scale)
 	scaled := math.Round(sc)
 	p, b := int(scaled)%len(sparks), block{full: int(scaled / float64(len(sparks)))}
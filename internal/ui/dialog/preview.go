@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dialog
+
+import (
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const previewKey = "preview"
+
+// ShowPreview pops a confirmation dialog with a scrollable preview of the changes.
+// The preview text may contain color tags.
+func ShowPreview(styles *config.Dialog, pages *ui.Pages, title, msg, preview, okLabel string, ack confirmFunc, cancel cancelFunc) {
+	v := tview.NewTextView().
+		SetDynamicColors(true).
+		SetWrap(false).
+		SetScrollable(true).
+		SetText(preview)
+	v.SetTextColor(styles.FgColor.Color())
+
+	f := tview.NewForm().
+		SetItemPadding(0).
+		SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+	f.AddButton("Cancel", func() {
+		dismissPreview(pages)
+		cancel()
+	})
+	f.AddButton(okLabel, func() {
+		dismissPreview(pages)
+		ack()
+	})
+	for i := range 2 {
+		if b := f.GetButton(i); b != nil {
+			b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+			b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+		}
+	}
+	f.SetFocus(0)
+
+	modal := ui.NewModalText("<"+title+">", v, f)
+	modal.SetText(msg, styles.FgColor.Color())
+	modal.SetDoneFunc(func() {
+		dismissPreview(pages)
+		cancel()
+	})
+	pages.AddPage(previewKey, modal, false, false)
+	pages.ShowPage(previewKey)
+}
+
+func dismissPreview(pages *ui.Pages) {
+	pages.RemovePage(previewKey)
+}
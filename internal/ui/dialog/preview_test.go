@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreviewDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+	ShowPreview(new(config.Dialog), p, "Blee", "Yo", "+fred", "Apply", func() {}, func() {})
+
+	d := p.GetPrimitive(previewKey).(*ui.ModalText)
+	assert.NotNil(t, d)
+	assert.True(t, p.IsTopDialog())
+
+	dismissPreview(p)
+	assert.Nil(t, p.GetPrimitive(previewKey))
+}
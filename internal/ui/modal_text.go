@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package ui
+
+import (
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const modalTextRatio = 0.8
+
+// ModalText represents a modal with a scrollable text body and a set of buttons.
+type ModalText struct {
+	*tview.Box
+
+	// The scrollable text embedded in the modal's frame.
+	view *tview.TextView
+
+	// The buttons form embedded in the modal's frame.
+	form *tview.Form
+
+	// The frame embedded in the modal.
+	frame *tview.Frame
+
+	// The optional callback for when the user cancels the modal.
+	done func()
+}
+
+// NewModalText returns a new scrollable text modal.
+func NewModalText(title string, view *tview.TextView, form *tview.Form) *ModalText {
+	m := ModalText{Box: tview.NewBox(), view: view, form: form}
+
+	m.view.SetBackgroundColor(tview.Styles.ContrastBackgroundColor)
+	m.form.SetBackgroundColor(tview.Styles.ContrastBackgroundColor).SetBorderPadding(0, 0, 0, 0)
+	m.form.SetCancelFunc(func() {
+		if m.done != nil {
+			m.done()
+		}
+	})
+
+	layout := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(m.view, 0, 1, false).
+		AddItem(m.form, 1, 0, true)
+	layout.SetBackgroundColor(tview.Styles.ContrastBackgroundColor)
+
+	m.frame = tview.NewFrame(layout).SetBorders(0, 0, 1, 0, 0, 0)
+	m.frame.SetBorder(true).
+		SetBackgroundColor(tview.Styles.ContrastBackgroundColor).
+		SetBorderPadding(1, 1, 1, 1)
+	m.frame.SetTitle(title)
+	m.frame.SetTitleColor(tcell.ColorAqua)
+
+	return &m
+}
+
+// SetDoneFunc sets a handler called when the modal is cancelled.
+func (m *ModalText) SetDoneFunc(handler func()) *ModalText {
+	m.done = handler
+	return m
+}
+
+// SetText sets the modal header text.
+func (m *ModalText) SetText(text string, color tcell.Color) *ModalText {
+	m.frame.Clear()
+	m.frame.AddText(text, true, tview.AlignCenter, color)
+
+	return m
+}
+
+// Draw draws this primitive onto the screen.
+func (m *ModalText) Draw(screen tcell.Screen) {
+	screenWidth, screenHeight := screen.Size()
+	width, height := int(float64(screenWidth)*modalTextRatio), int(float64(screenHeight)*modalTextRatio)
+	x, y := (screenWidth-width)/2, (screenHeight-height)/2
+	m.SetRect(x, y, width, height)
+
+	m.frame.SetRect(x, y, width, height)
+	m.frame.Draw(screen)
+}
+
+// Focus is called when this primitive receives focus.
+func (m *ModalText) Focus(delegate func(p tview.Primitive)) {
+	delegate(m.form)
+}
+
+// HasFocus returns whether this primitive has focus.
+func (m *ModalText) HasFocus() bool {
+	return m.form.HasFocus()
+}
+
+// MouseHandler returns the mouse handler for this primitive.
+func (m *ModalText) MouseHandler() func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (consumed bool, capture tview.Primitive) {
+	return m.WrapMouseHandler(func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (consumed bool, capture tview.Primitive) {
+		if m.view.InRect(event.Position()) {
+			consumed, _ = m.view.MouseHandler()(action, event, func(tview.Primitive) {})
+			return consumed, nil
+		}
+		consumed, capture = m.form.MouseHandler()(action, event, setFocus)
+		if !consumed && action == tview.MouseLeftClick && m.InRect(event.Position()) {
+			setFocus(m)
+			consumed = true
+		}
+		return
+	})
+}
+
+// InputHandler returns the handler for this primitive.
+// Navigation keys scroll the text while all other keys are handled by the buttons.
+func (m *ModalText) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return m.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		if isScrollKey(event) {
+			if handler := m.view.InputHandler(); handler != nil {
+				handler(event, setFocus)
+			}
+			return
+		}
+		if handler := m.form.InputHandler(); handler != nil {
+			handler(event, setFocus)
+		}
+	})
+}
+
+func isScrollKey(evt *tcell.EventKey) bool {
+	switch evt.Key() {
+	case tcell.KeyUp, tcell.KeyDown, tcell.KeyPgUp, tcell.KeyPgDn, tcell.KeyHome, tcell.KeyEnd:
+		return true
+	case tcell.KeyRune:
+		switch evt.Rune() {
+		case 'j', 'k', 'g', 'G':
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Alert presents an alert notifications viewer.
+type Alert struct {
+	ResourceViewer
+}
+
+// NewAlert returns a new viewer.
+func NewAlert(gvr *client.GVR) ResourceViewer {
+	a := Alert{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	a.GetTable().SetBorderFocusColor(tcell.ColorOrangeRed)
+	a.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorOrangeRed).Attributes(tcell.AttrNone))
+	a.GetTable().SetSortCol("LAST SEEN", true)
+	a.GetTable().SetEnterFn(a.showRes)
+	a.SetContextFn(a.alertContext)
+	a.AddBindKeysFn(a.bindKeys)
+
+	return &a
+}
+
+func (a *Alert) alertContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyAlertCenter, a.App().alerts)
+}
+
+func (a *Alert) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlW, tcell.KeyCtrlZ)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyA:        ui.NewKeyAction("Acknowledge", a.ackCmd, true),
+		tcell.KeyCtrlD: ui.NewKeyAction("Clear", a.clearCmd, true),
+		ui.KeyShiftS:   ui.NewKeyAction("Sort Severity", a.GetTable().SortColCmd("SEVERITY", true), false),
+		ui.KeyShiftT:   ui.NewKeyAction("Sort State", a.GetTable().SortColCmd("STATE", true), false),
+		ui.KeyShiftR:   ui.NewKeyAction("Sort Rule", a.GetTable().SortColCmd("RULE", true), false),
+		ui.KeyShiftL:   ui.NewKeyAction("Sort Last Seen", a.GetTable().SortColCmd("LAST SEEN", true), false),
+	})
+}
+
+func (a *Alert) showRes(app *App, _ ui.Tabular, _ *client.GVR, id string) {
+	for _, al := range app.alerts.Notifications() {
+		if al.ID != id {
+			continue
+		}
+		if al.GVR == nil {
+			app.Flash().Warnf("Unable to navigate to %s", al.Path)
+			return
+		}
+		app.gotoResource(al.GVR.String(), al.Path, false, true)
+		return
+	}
+	app.Flash().Err(fmt.Errorf("unable to locate alert %q", id))
+}
+
+func (a *Alert) ackCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ids := a.GetTable().GetSelectedItems()
+	if len(ids) == 0 {
+		return evt
+	}
+	a.App().alerts.Ack(ids...)
+	a.GetTable().ClearMarks()
+	a.App().Flash().Infof("Acknowledged %d alert(s)", len(ids))
+	a.Refresh()
+
+	return nil
+}
+
+func (a *Alert) clearCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ids := a.GetTable().GetSelectedItems()
+	if len(ids) == 0 {
+		return evt
+	}
+	a.App().alerts.Clear(ids...)
+	a.GetTable().ClearMarks()
+	a.App().Flash().Infof("Cleared %d alert(s)", len(ids))
+	a.Refresh()
+
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"maps"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"sort"
 	"strings"
 	"sync/atomic"
@@ -20,7 +21,10 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
@@ -49,7 +53,9 @@ type App struct {
 	command       *Command
 	factory       *watch.Factory
 	cancelFn      context.CancelFunc
+	pfCancelFn    context.CancelFunc
 	clusterModel  *model.ClusterInfo
+	alerts        *dao.AlertCenter
 	cmdHistory    *model.History
 	filterHistory *model.History
 	conRetry      int32
@@ -123,9 +129,12 @@ func (a *App) Init(version string, _ int) error {
 					a.clusterInfo().Init()
 				})
 			}()
+			a.autoStartPFProfiles()
 		}
 	}
 
+	a.initAlerts()
+
 	a.command = NewCommand(a)
 	if err := a.command.Init(a.Config.ContextAliasesPath()); err != nil {
 		return err
@@ -160,7 +169,14 @@ func (a *App) initImgScanner(version string) {
 	}(time.Now())
 
 	vul.ImgScanner = vul.NewImageScanner(a.Config.K9s.ImageScans, slog.Default())
-	go vul.ImgScanner.Init("k9s", version)
+	go func() {
+		vul.ImgScanner.Init("k9s", version)
+		if st := vul.ImgScanner.DBStatus(); !st.IsHealthy() {
+			a.QueueUpdateDraw(func() {
+				a.Flash().Warnf("Vulnerability database %s. Check `:vuldb` for details", st.State)
+			})
+		}
+	}()
 }
 
 func (a *App) layout(ctx context.Context) {
@@ -344,6 +360,9 @@ func (a *App) Resume() {
 	ctx, a.cancelFn = context.WithCancel(context.Background())
 
 	go a.clusterUpdater(ctx)
+	if a.factory != nil {
+		a.alerts.Watch(ctx)
+	}
 
 	if a.Config.K9s.UI.Reactive {
 		if err := a.ConfigWatcher(ctx, a); err != nil {
@@ -500,9 +519,16 @@ func (a *App) switchContext(ci *cmd.Interpreter, force bool) error {
 			a.clusterModel.AddListener(a.statusIndicator())
 		}
 
+		// Metrics trends and series are tracked per context.
+		if dao.MxRecorder != nil {
+			dao.MxRecorder.Clear()
+		}
+		a.cancelPFProfiles()
 		if a.factory != nil {
 			a.initFactory(ns)
+			a.autoStartPFProfiles()
 		}
+		a.initAlerts()
 
 		if err := a.command.Reset(a.Config.ContextAliasesPath(), true); err != nil {
 			return err
@@ -524,6 +550,73 @@ func (a *App) switchContext(ci *cmd.Interpreter, force bool) error {
 	return nil
 }
 
+func (a *App) initAlerts() {
+	aa := config.NewAlerts()
+	if err := aa.Load(a.Config.ContextAlertsPath()); err != nil {
+		slog.Warn("Unable to load alert rules", slogs.Error, err)
+	}
+	rr, err := aa.Rules()
+	if err != nil {
+		slog.Warn("Invalid alert rules", slogs.Error, err)
+	}
+	a.alerts = dao.NewAlertCenter(a.factory, rr)
+	a.alerts.AddListener(a)
+}
+
+// AlertFired notifies an alert fired.
+func (a *App) AlertFired(al render.AlertRes) {
+	a.Flash().Warnf("Alert %s [%s] %s: %s", al.Rule, al.Severity, al.Resource(), al.Message)
+}
+
+// autoStartPFProfiles starts the current context auto start port-forward profiles.
+// Any pending auto start is canceled first.
+func (a *App) autoStartPFProfiles() {
+	a.cancelPFProfiles()
+	var ctx context.Context
+	ctx, a.pfCancelFn = context.WithCancel(context.Background())
+	go a.startPFProfiles(ctx)
+}
+
+// cancelPFProfiles cancels a pending port-forward profiles auto start.
+func (a *App) cancelPFProfiles() {
+	if a.pfCancelFn != nil {
+		a.pfCancelFn()
+		a.pfCancelFn = nil
+	}
+}
+
+func (a *App) startPFProfiles(ctx context.Context) {
+	pp := config.NewPFProfiles()
+	if err := pp.Load(a.Config.ContextPFProfilesPath()); err != nil {
+		slog.Warn("Unable to load port-forward profiles", slogs.Error, err)
+		return
+	}
+	for i := range pp.Profiles {
+		if ctx.Err() != nil {
+			return
+		}
+		pr := &pp.Profiles[i]
+		if !pr.AutoStart {
+			continue
+		}
+		n, err := startPFProfile(ctx, a, pr)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			slog.Warn("PortForward profile auto start failed",
+				slogs.Name, pr.Name,
+				slogs.Error, err,
+			)
+			a.Flash().Warnf("PortForward profile %q: %s", pr.Name, err)
+			continue
+		}
+		if n > 0 {
+			a.Flash().Infof("PortForward profile %q started (%d forwards)", pr.Name, n)
+		}
+	}
+}
+
 func (a *App) initFactory(ns string) {
 	a.factory.Terminate()
 	a.factory.Start(ns)
@@ -695,6 +788,32 @@ func (a *App) dirCmd(path string, pushCmd bool) error {
 	return a.inject(NewDir(path), true)
 }
 
+func (a *App) snapshotCmd() {
+	if a.factory == nil {
+		a.Flash().Errf("No connection available")
+		return
+	}
+	a.Flash().Info("Capturing cluster snapshot...")
+	go func() {
+		snap, err := a.factory.Snapshot(context.Background(), a.Config.ActiveNamespace())
+		if err != nil {
+			a.Flash().Errf("Snapshot failed: %s", err)
+			return
+		}
+		dir := a.Config.K9s.ContextScreenDumpDir()
+		if err := data.EnsureDirPath(dir, data.DefaultDirMod); err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		path := filepath.Join(dir, data.SanitizeFileName(snap.FileName()))
+		if err := snap.Save(path); err != nil {
+			a.Flash().Errf("Snapshot save failed: %s", err)
+			return
+		}
+		a.Flash().Infof("Snapshot saved to %s", path)
+	}()
+}
+
 func (a *App) quitCmd(evt *tcell.EventKey) *tcell.EventKey {
 	noExit := a.Config.K9s.NoExitOnCtrlC
 	if a.InCmdMode() {
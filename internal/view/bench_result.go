@@ -0,0 +1,335 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/tchart"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	benchResultTitle = "Results"
+	benchDiffTitle   = "Results Diff"
+)
+
+// BenchRun represents a named benchmark run result.
+type BenchRun struct {
+	Name   string
+	Result *perf.Result
+}
+
+// BenchResult presents benchmark results charts. When given two runs
+// the charts overlay both runs and the summary shows their deltas.
+type BenchResult struct {
+	*tview.Flex
+
+	app     *App
+	actions *ui.KeyActions
+	subject string
+	runs    []BenchRun
+	summary *tview.TextView
+}
+
+// NewBenchResult returns a new benchmark results viewer.
+func NewBenchResult(app *App, subject string, runs ...BenchRun) *BenchResult {
+	return &BenchResult{
+		Flex:    tview.NewFlex(),
+		app:     app,
+		actions: ui.NewKeyActions(),
+		subject: subject,
+		runs:    runs,
+		summary: tview.NewTextView(),
+	}
+}
+
+func (*BenchResult) SetCommand(*cmd.Interpreter)            {}
+func (*BenchResult) SetFilter(string, bool)                 {}
+func (*BenchResult) SetLabelSelector(labels.Selector, bool) {}
+
+// Init initializes the viewer.
+func (b *BenchResult) Init(context.Context) error {
+	if len(b.runs) == 0 {
+		return fmt.Errorf("no benchmark results for %s", b.subject)
+	}
+	b.SetBorder(true)
+	b.SetBorderPadding(0, 0, 1, 1)
+	b.SetDirection(tview.FlexRow)
+	frame := b.app.Styles.Frame()
+	b.SetTitle(ui.SkinTitle(fmt.Sprintf(detailsTitleFmt, b.Name(), b.subject), &frame))
+
+	b.summary.SetDynamicColors(true)
+	b.summary.SetText(b.summaryText())
+
+	charts := tview.NewFlex().SetDirection(tview.FlexColumn)
+	charts.AddItem(b.latencyChart(), 0, 2, false)
+	charts.AddItem(b.codesChart(), 0, 2, false)
+	charts.AddItem(b.statusGauge(), 0, 1, false)
+
+	histos := tview.NewFlex().SetDirection(tview.FlexColumn)
+	for i := range b.runs {
+		histos.AddItem(b.histogramChart(i), 0, 1, false)
+	}
+
+	b.AddItem(b.summary, len(strings.Split(b.summary.GetText(false), "\n"))+1, 0, false)
+	b.AddItem(charts, 0, 1, false)
+	b.AddItem(histos, 0, 1, false)
+
+	b.app.Styles.AddListener(b)
+	b.StylesChanged(b.app.Styles)
+	b.bindKeys()
+	b.SetInputCapture(b.keyboard)
+
+	return nil
+}
+
+// StylesChanged notifies the skin changed.
+func (b *BenchResult) StylesChanged(s *config.Styles) {
+	b.SetBackgroundColor(s.BgColor())
+	b.summary.SetBackgroundColor(s.BgColor())
+	b.summary.SetTextColor(s.FgColor())
+	b.SetBorderFocusColor(s.Frame().Border.FocusColor.Color())
+}
+
+// InCmdMode checks if prompt is active.
+func (*BenchResult) InCmdMode() bool {
+	return false
+}
+
+// Name returns the component name.
+func (b *BenchResult) Name() string {
+	if len(b.runs) > 1 {
+		return benchDiffTitle
+	}
+
+	return benchResultTitle
+}
+
+// Start starts the view updater.
+func (*BenchResult) Start() {}
+
+// Stop terminates the updater.
+func (b *BenchResult) Stop() {
+	b.app.Styles.RemoveListener(b)
+}
+
+// Actions returns menu actions.
+func (b *BenchResult) Actions() *ui.KeyActions {
+	return b.actions
+}
+
+// Hints returns menu hints.
+func (b *BenchResult) Hints() model.MenuHints {
+	return b.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (*BenchResult) ExtraHints() map[string]string {
+	return nil
+}
+
+func (b *BenchResult) bindKeys() {
+	b.actions.Bulk(ui.KeyMap{
+		tcell.KeyEscape: ui.NewKeyAction("Back", b.app.PrevCmd, false),
+		ui.KeyQ:         ui.NewKeyAction("Back", b.app.PrevCmd, false),
+	})
+}
+
+func (b *BenchResult) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := b.actions.Get(ui.AsKey(evt)); ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (b *BenchResult) summaryText() string {
+	if len(b.runs) == 1 {
+		r := b.runs[0].Result
+		ll := []string{
+			fmt.Sprintf("[aqua::b]Run:[-::-] %s", b.runs[0].Name),
+			fmt.Sprintf("[aqua::b]Total:[-::-] %.4fs  [aqua::b]Req/s:[-::-] %.4f  [aqua::b]Fastest:[-::-] %.4fs  [aqua::b]Average:[-::-] %.4fs  [aqua::b]Slowest:[-::-] %.4fs",
+				r.Total, r.RPS, r.Fastest, r.Average, r.Slowest),
+		}
+		pp := make([]string, 0, len(perf.KeyPercentiles))
+		for _, p := range perf.KeyPercentiles {
+			v, _ := r.Percentile(p)
+			pp = append(pp, fmt.Sprintf("[aqua::b]p%d:[-::-] %.4fs", p, v))
+		}
+		ll = append(ll, strings.Join(pp, "  "))
+		ee := make([]string, 0, len(r.Errors))
+		for e := range r.Errors {
+			ee = append(ee, e)
+		}
+		slices.Sort(ee)
+		for _, e := range ee {
+			ll = append(ll, fmt.Sprintf("[red::]%d x %s[-::]", r.Errors[e], tview.Escape(e)))
+		}
+		return strings.Join(ll, "\n")
+	}
+
+	a, o := b.runs[0], b.runs[1]
+	ll := []string{
+		fmt.Sprintf("[aqua::b]A:[-::-] %s", a.Name),
+		fmt.Sprintf("[aqua::b]B:[-::-] %s", o.Name),
+		fmt.Sprintf("[white::b]%-14s %14s %14s %10s[-::-]", "METRIC", "A", "B", "CHANGE"),
+	}
+	for _, d := range perf.Compare(a.Result, o.Result) {
+		ll = append(ll, fmt.Sprintf("%-14s %14s %14s %s", d.Metric, fmtDeltaVal(d.A, d.Unit), fmtDeltaVal(d.B, d.Unit), fmtChange(d)))
+	}
+
+	return strings.Join(ll, "\n")
+}
+
+func (b *BenchResult) latencyChart() *tchart.BarChart {
+	c := b.makeBarChart("latency", "s", b.legend("Latency"))
+	ll := make([]string, 0, len(perf.KeyPercentiles))
+	for _, p := range perf.KeyPercentiles {
+		ll = append(ll, "p"+strconv.Itoa(p))
+	}
+	c.SetLabels(ll...)
+	for _, r := range b.runs {
+		vv := make([]float64, 0, len(perf.KeyPercentiles))
+		for _, p := range perf.KeyPercentiles {
+			v, _ := r.Result.Percentile(p)
+			vv = append(vv, v)
+		}
+		c.AddSeries(vv...)
+	}
+
+	return c
+}
+
+func (b *BenchResult) codesChart() *tchart.BarChart {
+	c := b.makeBarChart("codes", "", b.legend("Status Codes"))
+	codes := make(map[int]struct{})
+	for _, r := range b.runs {
+		for _, code := range r.Result.Codes() {
+			codes[code] = struct{}{}
+		}
+	}
+	cc := make([]int, 0, len(codes))
+	for code := range codes {
+		cc = append(cc, code)
+	}
+	ll := make([]string, 0, len(cc)+1)
+	slices.Sort(cc)
+	for _, code := range cc {
+		ll = append(ll, strconv.Itoa(code))
+	}
+	ll = append(ll, "err")
+	c.SetLabels(ll...)
+	for _, r := range b.runs {
+		vv := make([]float64, 0, len(ll))
+		for _, code := range cc {
+			vv = append(vv, float64(r.Result.StatusCodes[code]))
+		}
+		var errs int
+		for _, n := range r.Result.Errors {
+			errs += n
+		}
+		vv = append(vv, float64(errs))
+		c.AddSeries(vv...)
+	}
+
+	return c
+}
+
+func (b *BenchResult) statusGauge() *tchart.Gauge {
+	g := tchart.NewGauge("status")
+	g.SetBorder(true)
+	g.SetBackgroundColor(b.app.Styles.Charts().BgColor.Color())
+	g.SetSeriesColors(b.app.Styles.Charts().DefaultDialColors.Colors()...)
+	g.SetLegend(" OK/Failed ")
+	for _, r := range b.runs {
+		g.Add(r.Result.OK(), r.Result.Failed())
+	}
+
+	return g
+}
+
+func (b *BenchResult) histogramChart(i int) *tchart.BarChart {
+	legend := " Histogram "
+	if len(b.runs) > 1 {
+		legend = fmt.Sprintf(" Histogram (%c) ", 'A'+i)
+	}
+	c := b.makeBarChart(fmt.Sprintf("histogram-%d", i), "", legend)
+	r := b.runs[i].Result
+	ll, vv := make([]string, 0, len(r.Histogram)), make([]float64, 0, len(r.Histogram))
+	for _, bu := range r.Histogram {
+		ll = append(ll, strconv.FormatFloat(bu.Mark, 'f', 3, 64))
+		vv = append(vv, float64(bu.Count))
+	}
+	c.SetLabels(ll...)
+	c.AddSeries(vv...)
+	if i > 0 {
+		cc := b.app.Styles.Charts().DefaultChartColors.Colors()
+		c.SetSeriesColors(append(cc[i%len(cc):], cc[:i%len(cc)]...)...)
+	}
+
+	return c
+}
+
+func (b *BenchResult) legend(title string) string {
+	if len(b.runs) > 1 {
+		return " " + title + " (A|B) "
+	}
+
+	return " " + title + " "
+}
+
+func (b *BenchResult) makeBarChart(id, unit, legend string) *tchart.BarChart {
+	c := tchart.NewBarChart(id, unit)
+	c.SetBorder(true)
+	c.SetBackgroundColor(b.app.Styles.Charts().BgColor.Color())
+	c.SetSeriesColors(b.app.Styles.Charts().DefaultChartColors.Colors()...)
+	c.SetLegend(legend)
+
+	return c
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func fmtDeltaVal(v float64, unit string) string {
+	if unit == "" {
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+
+	return strconv.FormatFloat(v, 'f', 4, 64) + unit
+}
+
+func fmtChange(d perf.Delta) string {
+	c, ok := d.Change()
+	if !ok {
+		return render.NAValue
+	}
+	color := "white"
+	switch {
+	case c == 0:
+	case (c > 0) == deltaHigherIsBetter(d.Metric):
+		color = "green"
+	default:
+		color = "red"
+	}
+
+	return fmt.Sprintf("[%s::]%+.1f%%[-::]", color, c)
+}
+
+func deltaHigherIsBetter(metric string) bool {
+	return metric == "Requests/sec" || metric == "2xx"
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBenchConfigKey(t *testing.T) {
+	uu := map[string]struct {
+		path, e string
+	}{
+		"plain": {
+			path: "/tmp/bench/default_fred_1577308050814961000.txt",
+			e:    "default_fred",
+		},
+		"container": {
+			path: "default_fred_blee_8080_1577308050814961000.txt",
+			e:    "default_fred_blee_8080",
+		},
+		"none": {
+			path: "fred.txt",
+			e:    "fred.txt",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, benchConfigKey(u.path))
+		})
+	}
+}
+
+func TestFmtChange(t *testing.T) {
+	uu := map[string]struct {
+		d perf.Delta
+		e string
+	}{
+		"na": {
+			d: perf.Delta{Metric: "p99", B: 1},
+			e: "n/a",
+		},
+		"same": {
+			d: perf.Delta{Metric: "p99", A: 1, B: 1},
+			e: "[white::]+0.0%[-::]",
+		},
+		"slower": {
+			d: perf.Delta{Metric: "p99", A: 1, B: 2},
+			e: "[red::]+100.0%[-::]",
+		},
+		"faster": {
+			d: perf.Delta{Metric: "p99", A: 2, B: 1},
+			e: "[green::]-50.0%[-::]",
+		},
+		"more-rps": {
+			d: perf.Delta{Metric: "Requests/sec", A: 10, B: 15},
+			e: "[green::]+50.0%[-::]",
+		},
+		"less-ok": {
+			d: perf.Delta{Metric: "2xx", A: 10, B: 5},
+			e: "[red::]-50.0%[-::]",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, fmtChange(u.d))
+		})
+	}
+}
+
+func TestFmtDeltaVal(t *testing.T) {
+	assert.Equal(t, "0.1234s", fmtDeltaVal(0.12341, "s"))
+	assert.Equal(t, "100", fmtDeltaVal(100, ""))
+}
@@ -8,18 +8,24 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/perf"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 )
 
+const benchProgressRate = time.Second
+
 // Benchmark represents a service benchmark results view.
 type Benchmark struct {
 	ResourceViewer
@@ -35,10 +41,18 @@ func NewBenchmark(gvr *client.GVR) ResourceViewer {
 	b.GetTable().SetSortCol(ageCol, true)
 	b.SetContextFn(b.benchContext)
 	b.GetTable().SetEnterFn(b.viewBench)
+	b.AddBindKeysFn(b.bindKeys)
 
 	return &b
 }
 
+func (b *Benchmark) bindKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyR:      ui.NewKeyAction("Charts", b.chartsCmd, true),
+		ui.KeyShiftD: ui.NewKeyAction(diffAction, b.diffCmd, true),
+	})
+}
+
 func (b *Benchmark) benchContext(ctx context.Context) context.Context {
 	return context.WithValue(ctx, internal.KeyDir, benchDir(b.App().Config))
 }
@@ -56,6 +70,57 @@ func (b *Benchmark) viewBench(app *App, _ ui.Tabular, _ *client.GVR, path string
 	}
 }
 
+func (b *Benchmark) chartsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	r, err := perf.LoadResult(path)
+	if err != nil {
+		b.App().Flash().Errf("Unable to load bench results %s", err)
+		return nil
+	}
+
+	v := NewBenchResult(b.App(), fileToSubject(path), BenchRun{Name: filepath.Base(path), Result: r})
+	if err := b.App().inject(v, false); err != nil {
+		b.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (b *Benchmark) diffCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sels := b.GetTable().GetSelectedItems()
+	if len(sels) == 0 {
+		return evt
+	}
+	if len(sels) != 2 {
+		b.App().Flash().Warn("Mark two benchmark runs to diff them")
+		return nil
+	}
+	slices.Sort(sels)
+	if benchConfigKey(sels[0]) != benchConfigKey(sels[1]) {
+		b.App().Flash().Warn("Only runs of the same benchmark config can be diffed")
+		return nil
+	}
+	runs := make([]BenchRun, 0, len(sels))
+	for _, path := range sels {
+		r, err := perf.LoadResult(path)
+		if err != nil {
+			b.App().Flash().Errf("Unable to load bench results %s", err)
+			return nil
+		}
+		runs = append(runs, BenchRun{Name: filepath.Base(path), Result: r})
+	}
+
+	v := NewBenchResult(b.App(), fileToSubject(sels[0]), runs...)
+	if err := b.App().inject(v, false); err != nil {
+		b.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (b *Benchmark) benchFile() string {
 	r := b.GetTable().GetSelectedRowIndex()
 	return ui.TrimCell(b.GetTable().SelectTable, r, 7)
@@ -70,6 +135,16 @@ func fileToSubject(path string) string {
 	return ee[0] + "/" + ee[1]
 }
 
+// benchConfigKey returns the benchmark config a run report belongs to.
+func benchConfigKey(path string) string {
+	n := filepath.Base(path)
+	if i := strings.LastIndex(n, "_"); i > 0 {
+		return n[:i]
+	}
+
+	return n
+}
+
 func benchDir(cfg *config.Config) string {
 	ct, err := cfg.K9s.ActiveContext()
 	if err != nil {
@@ -92,3 +167,36 @@ func readBenchFile(cfg *config.Config, n string) (string, error) {
 
 	return string(bb), nil
 }
+
+// trackBenchProgress reports a running benchmark progress in the app status.
+func trackBenchProgress(a *App, b *perf.Benchmark) {
+	ticker := time.NewTicker(benchProgressRate)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-b.Done():
+			return
+		case <-ticker.C:
+			p := b.Progress()
+			a.QueueUpdateDraw(func() {
+				if benchOver(b) {
+					return
+				}
+				if a.showHeader {
+					a.setLogo(model.FlashWarn, p.String())
+				} else {
+					a.setIndicator(model.FlashWarn, p.String())
+				}
+			})
+		}
+	}
+}
+
+func benchOver(b *perf.Benchmark) bool {
+	select {
+	case <-b.Done():
+		return true
+	default:
+		return b.Canceled()
+	}
+}
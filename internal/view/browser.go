@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -537,6 +538,67 @@ func (b *Browser) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (b *Browser) diffCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sels := b.GetSelectedItems()
+	if len(sels) == 0 {
+		return evt
+	}
+
+	var (
+		subject, diff string
+		err           error
+	)
+	switch len(sels) {
+	case 1:
+		subject = sels[0]
+		diff, err = b.diffLastApplied(sels[0])
+	case 2:
+		slices.Sort(sels)
+		subject = sels[0] + " <> " + sels[1]
+		diff, err = b.diffMarked(sels[0], sels[1])
+	default:
+		b.app.Flash().Warn("Mark two resources to diff them")
+		return nil
+	}
+	if err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+	if diff == "" {
+		b.app.Flash().Infof("No differences found for %s", subject)
+		return nil
+	}
+
+	details := NewDetails(b.app, diffAction, subject, contentDiff, true).Update(diff)
+	if err := b.app.inject(details, false); err != nil {
+		b.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (b *Browser) diffLastApplied(path string) (string, error) {
+	o, err := b.app.factory.Get(b.GVR(), path, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+
+	return dao.DiffLastApplied(o)
+}
+
+func (b *Browser) diffMarked(path1, path2 string) (string, error) {
+	o1, err := b.app.factory.Get(b.GVR(), path1, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	o2, err := b.app.factory.Get(b.GVR(), path2, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+
+	return dao.DiffObjects(path1, o1, path2, o2)
+}
+
 func (b *Browser) editCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := b.GetSelectedItem()
 	if path == "" {
@@ -681,6 +743,7 @@ func (b *Browser) refreshActions() {
 	if !dao.IsK9sMeta(b.meta) {
 		aa.Add(ui.KeyY, ui.NewKeyAction(yamlAction, b.viewCmd, true))
 		aa.Add(ui.KeyD, ui.NewKeyAction("Describe", b.describeCmd, true))
+		aa.Add(ui.KeyShiftD, ui.NewKeyAction(diffAction, b.diffCmd, true))
 	}
 	for _, f := range b.bindKeysFn {
 		f(aa)
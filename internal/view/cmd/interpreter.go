@@ -5,6 +5,7 @@ package cmd
 
 import (
 	"log/slog"
+	"slices"
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
@@ -205,6 +206,11 @@ func (c *Interpreter) IsDirCmd() bool {
 	return dirCmd.Has(c.cmd)
 }
 
+// IsSnapshotCmd returns true if snapshot cmd is detected.
+func (c *Interpreter) IsSnapshotCmd() bool {
+	return c.cmd == snapshotCmd
+}
+
 // IsRBACCmd returns true if rbac cmd is detected.
 func (c *Interpreter) IsRBACCmd() bool {
 	return c.cmd == canCmd
@@ -309,6 +315,29 @@ func (c *Interpreter) HasContext() (string, bool) {
 	return ctx, ok && ctx != ""
 }
 
+// FleetArg returns the contexts to aggregate when several contexts are requested.
+// A single AllContexts entry designates all known contexts.
+func (c *Interpreter) FleetArg() ([]string, bool) {
+	ctx, ok := c.HasContext()
+	if !ok {
+		return nil, false
+	}
+	if ctx == AllContexts {
+		return []string{AllContexts}, true
+	}
+	if !strings.Contains(ctx, contextSep) {
+		return nil, false
+	}
+	cc := make([]string, 0, strings.Count(ctx, contextSep)+1)
+	for _, ct := range strings.Split(ctx, contextSep) {
+		if ct = strings.TrimSpace(ct); ct != "" && !slices.Contains(cc, ct) {
+			cc = append(cc, ct)
+		}
+	}
+
+	return cc, len(cc) > 0
+}
+
 // LabelsSelector returns the label selector if any.
 func (c *Interpreter) LabelsSelector() (labels.Selector, error) {
 	return labels.Parse(c.args[labelKey])
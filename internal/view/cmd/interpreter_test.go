@@ -705,3 +705,49 @@ func Test_grokLabels(t *testing.T) {
 		})
 	}
 }
+
+func TestFleetArg(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		ok  bool
+		cc  []string
+	}{
+		"empty": {},
+
+		"no-context": {
+			cmd: "po fred",
+		},
+
+		"single-context": {
+			cmd: "po @fred",
+		},
+
+		"multi": {
+			cmd: "po @fred,blee",
+			ok:  true,
+			cc:  []string{"fred", "blee"},
+		},
+
+		"multi-dups": {
+			cmd: "po ns1 @fred,,blee,fred /zorg",
+			ok:  true,
+			cc:  []string{"fred", "blee"},
+		},
+
+		"all": {
+			cmd: "po @*",
+			ok:  true,
+			cc:  []string{cmd.AllContexts},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			cc, ok := p.FleetArg()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.cc, cc)
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 const (
 	cowCmd         = "cow"
 	canCmd         = "can"
+	snapshotCmd    = "snapshot"
 	nsFlag         = "-n"
 	filterFlag     = "/"
 	labelFlagEq    = "="
@@ -23,6 +24,10 @@ const (
 	label
 	fuzzyFlag   = "-f"
 	contextFlag = "@"
+	contextSep  = ","
+
+	// AllContexts designates all known contexts.
+	AllContexts = "*"
 )
 
 var (
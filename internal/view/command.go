@@ -28,7 +28,7 @@ const (
 
 var (
 	customViewers MetaViewers
-	contextRX     = regexp.MustCompile(`\s+@([\w-]+)`)
+	contextRX     = regexp.MustCompile(`\s+@([\w,*-]+)`)
 )
 
 // Command represents a user command.
@@ -93,10 +93,30 @@ var allowedCmds = sets.New[*client.GVR](
 	client.DsGVR,
 	client.StsGVR,
 	client.RsGVR,
+	client.IngGVR,
+	client.HpaGVR,
+	client.PdbGVR,
+	client.NpGVR,
+	client.PvcGVR,
 )
 
-func allowedXRay(gvr *client.GVR) bool {
-	return allowedCmds.Has(gvr)
+// allowedXRay returns the xray gvr for a given resource. Resources served
+// under a different version than the xray one map to the latter. Custom
+// resources are walked via their owner references.
+func allowedXRay(gvr *client.GVR) (*client.GVR, bool) {
+	if allowedCmds.Has(gvr) {
+		return gvr, true
+	}
+	for g := range allowedCmds {
+		if g.G() == gvr.G() && g.R() == gvr.R() {
+			return g, true
+		}
+	}
+	if m, err := dao.MetaAccess.MetaFor(gvr); err == nil && dao.IsCRD(m) {
+		return gvr, true
+	}
+
+	return nil, false
 }
 
 func (c *Command) contextCmd(p *cmd.Interpreter, pushCmd bool) error {
@@ -155,7 +175,8 @@ func (c *Command) xrayCmd(p *cmd.Interpreter, pushCmd bool) error {
 	if !ok {
 		return fmt.Errorf("invalid resource name: %q", arg)
 	}
-	if !allowedXRay(gvr) {
+	gvr, ok = allowedXRay(gvr)
+	if !ok {
 		return fmt.Errorf("unsupported resource %q", arg)
 	}
 	ns := c.app.Config.ActiveNamespace()
@@ -185,7 +206,8 @@ func (c *Command) run(p *cmd.Interpreter, fqn string, clearStack, pushCmd bool)
 		p.Merge(comd)
 	}
 
-	if context, ok := p.HasContext(); ok {
+	fleet, isFleet := p.FleetArg()
+	if context, ok := p.HasContext(); ok && !isFleet {
 		if context != c.app.Config.ActiveContextName() {
 			if err := c.app.Config.Save(true); err != nil {
 				slog.Error("Config save failed during command exec", slogs.Error, err)
@@ -223,7 +245,12 @@ func (c *Command) run(p *cmd.Interpreter, fqn string, clearStack, pushCmd bool)
 		p.ClearNS()
 	}
 
-	co := c.componentFor(gvr, fqn, v)
+	var co ResourceViewer
+	if isFleet {
+		co = NewFleet(gvr, fleet)
+	} else {
+		co = c.componentFor(gvr, fqn, v)
+	}
 	co.SetFilter("", true)
 	co.SetLabelSelector(labels.Everything(), true)
 	if f, ok := p.FilterArg(); ok {
@@ -299,6 +326,8 @@ func (c *Command) specialCmd(p *cmd.Interpreter, pushCmd bool) bool {
 		}
 	case p.IsNamespaceCmd():
 		return c.namespaceCmd(p)
+	case p.IsSnapshotCmd():
+		c.app.snapshotCmd()
 	case p.IsDirCmd():
 		if a, ok := p.DirArg(); !ok {
 			c.app.Flash().Errf("Invalid command. Use `dir xxx`")
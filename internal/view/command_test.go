@@ -9,6 +9,7 @@ import (
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/view/cmd"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func Test_viewMetaFor(t *testing.T) {
@@ -82,3 +83,47 @@ func Test_viewMetaFor(t *testing.T) {
 		})
 	}
 }
+
+func Test_allowedXRay(t *testing.T) {
+	crd := client.NewGVR("argoproj.io/v1alpha1/rollouts")
+	dao.MetaAccess.RegisterMeta(crd.String(), &metav1.APIResource{
+		Name:       crd.R(),
+		Group:      crd.G(),
+		Version:    crd.V(),
+		Kind:       "Rollout",
+		Namespaced: true,
+		Categories: []string{"crd"},
+	})
+
+	uu := map[string]struct {
+		gvr, e *client.GVR
+		ok     bool
+	}{
+		"pod": {
+			gvr: client.PodGVR,
+			e:   client.PodGVR,
+			ok:  true,
+		},
+		"hpa-v2": {
+			gvr: client.NewGVR("autoscaling/v2/horizontalpodautoscalers"),
+			e:   client.HpaGVR,
+			ok:  true,
+		},
+		"crd": {
+			gvr: crd,
+			e:   crd,
+			ok:  true,
+		},
+		"unsupported": {
+			gvr: client.CmGVR,
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			gvr, ok := allowedXRay(u.gvr)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, gvr)
+		})
+	}
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/tview"
+)
+
+const (
+	diffAction    = "Diff"
+	diffHeaderFmt = "[%s::b]%s[-::-]"
+	diffLineFmt   = "[%s::]%s[-::]"
+)
+
+func colorizeDiff(style config.Diff, raw string) string {
+	lines := strings.Split(tview.Escape(raw), "\n")
+	buff := make([]string, 0, len(lines))
+	for _, l := range lines {
+		var (
+			fmat  = diffLineFmt
+			color config.Color
+		)
+		switch {
+		case strings.HasPrefix(l, "+++"), strings.HasPrefix(l, "---"), strings.HasPrefix(l, "diff "):
+			fmat, color = diffHeaderFmt, style.HeaderColor
+		case strings.HasPrefix(l, "@@"):
+			color = style.HunkColor
+		case strings.HasPrefix(l, "+"):
+			color = style.AddColor
+		case strings.HasPrefix(l, "-"):
+			color = style.DelColor
+		default:
+			buff = append(buff, enableRegion(l))
+			continue
+		}
+		buff = append(buff, enableRegion(fmt.Sprintf(fmat, color, l)))
+	}
+
+	return strings.Join(buff, "\n")
+}
+
+type changeKind int
+
+const (
+	changeCreated changeKind = iota
+	changeChanged
+	changePruned
+)
+
+func (c changeKind) String() string {
+	switch c {
+	case changeCreated:
+		return "created"
+	case changePruned:
+		return "pruned"
+	default:
+		return "changed"
+	}
+}
+
+// objectChange tracks a resource change reported by a dry-run diff.
+type objectChange struct {
+	name string
+	kind changeKind
+}
+
+// parseDiffChanges extracts the changed resources from a kubectl diff output.
+// Resources are reported as created when absent from the live side and as pruned when absent from the merged side.
+func parseDiffChanges(raw string) []objectChange {
+	var (
+		cc      []objectChange
+		current *objectChange
+		hunked  bool
+	)
+	for _, l := range strings.Split(raw, "\n") {
+		switch {
+		case strings.HasPrefix(l, "+++ "):
+			f, _, _ := strings.Cut(strings.TrimPrefix(l, "+++ "), "\t")
+			cc = append(cc, objectChange{name: path.Base(strings.TrimSpace(f)), kind: changeChanged})
+			current, hunked = &cc[len(cc)-1], false
+		case strings.HasPrefix(l, "@@ ") && current != nil && !hunked:
+			hunked = true
+			switch {
+			case strings.HasPrefix(l, "@@ -0,0 "):
+				current.kind = changeCreated
+			case strings.HasSuffix(strings.TrimSpace(strings.TrimSuffix(l, "@@")), " +0,0"):
+				current.kind = changePruned
+			}
+		}
+	}
+
+	return cc
+}
+
+// summarizeChanges returns a dry-run changes count by kind and a listing of the affected resources.
+func summarizeChanges(cc []objectChange) (string, string) {
+	var counts [3]int
+	ll := make([]string, 0, len(cc))
+	for _, c := range cc {
+		counts[c.kind]++
+		ll = append(ll, fmt.Sprintf("%-8s %s", c.kind, c.name))
+	}
+	summary := fmt.Sprintf("%d created, %d changed, %d pruned",
+		counts[changeCreated],
+		counts[changeChanged],
+		counts[changePruned],
+	)
+
+	return summary, strings.Join(ll, "\n")
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColorizeDiff(t *testing.T) {
+	raw := "--- a/fred\n+++ b/fred\n@@ -1 +1 @@\n-a: [x]\n+a: b\n c"
+	style := config.Diff{HeaderColor: "#ffffff", HunkColor: "#00ffff", AddColor: "#00ff00", DelColor: "#ff0000"}
+
+	assert.Equal(t,
+		"[#ffffff::b]--- a/fred[-::-]\n"+
+			"[#ffffff::b]+++ b/fred[-::-]\n"+
+			"[#00ffff::]@@ -1 +1 @@[-::]\n"+
+			"[#ff0000::]-a: [x[][-::]\n"+
+			"[#00ff00::]+a: b[-::]\n"+
+			" c", colorizeDiff(style, raw))
+}
+
+func TestParseDiffChanges(t *testing.T) {
+	raw := `diff -u -N /tmp/LIVE-1/v1.ConfigMap.default.cm1 /tmp/MERGED-2/v1.ConfigMap.default.cm1
+--- /tmp/LIVE-1/v1.ConfigMap.default.cm1	2025-01-01 00:00:00.000000000 +0000
++++ /tmp/MERGED-2/v1.ConfigMap.default.cm1	2025-01-01 00:00:00.000000000 +0000
+@@ -0,0 +1,4 @@
++apiVersion: v1
++kind: ConfigMap
++metadata:
++  name: cm1
+diff -u -N /tmp/LIVE-1/apps.v1.Deployment.default.fred /tmp/MERGED-2/apps.v1.Deployment.default.fred
+--- /tmp/LIVE-1/apps.v1.Deployment.default.fred	2025-01-01 00:00:00.000000000 +0000
++++ /tmp/MERGED-2/apps.v1.Deployment.default.fred	2025-01-01 00:00:00.000000000 +0000
+@@ -6,7 +6,7 @@
+ spec:
+-  replicas: 1
++  replicas: 3
+@@ -0,0 +20,1 @@
++  paused: true
+diff -u -N /tmp/LIVE-1/v1.Service.default.blee /tmp/MERGED-2/v1.Service.default.blee
+--- /tmp/LIVE-1/v1.Service.default.blee	2025-01-01 00:00:00.000000000 +0000
++++ /tmp/MERGED-2/v1.Service.default.blee	1970-01-01 00:00:00.000000000 +0000
+@@ -1,2 +0,0 @@
+-apiVersion: v1
+-kind: Service`
+
+	cc := parseDiffChanges(raw)
+	assert.Equal(t, []objectChange{
+		{name: "v1.ConfigMap.default.cm1", kind: changeCreated},
+		{name: "apps.v1.Deployment.default.fred", kind: changeChanged},
+		{name: "v1.Service.default.blee", kind: changePruned},
+	}, cc)
+
+	summary, listing := summarizeChanges(cc)
+	assert.Equal(t, "1 created, 1 changed, 1 pruned", summary)
+	assert.Equal(t, "created  v1.ConfigMap.default.cm1\nchanged  apps.v1.Deployment.default.fred\npruned   v1.Service.default.blee", listing)
+}
+
+func TestParseDiffChangesEmpty(t *testing.T) {
+	cc := parseDiffChanges("")
+	assert.Empty(t, cc)
+
+	summary, listing := summarizeChanges(cc)
+	assert.Equal(t, "0 created, 0 changed, 0 pruned", summary)
+	assert.Empty(t, listing)
+}
@@ -5,8 +5,10 @@ package view
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"os/exec"
 	"path"
 	"slices"
 	"strings"
@@ -16,6 +18,8 @@ import (
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 const (
@@ -25,6 +29,7 @@ const (
 	kustomizeYML   = kustomize + extYML
 	extYAML        = ".yaml"
 	extYML         = ".yml"
+	pruneDialogKey = "prune"
 )
 
 // Dir represents a command directory view.
@@ -66,6 +71,10 @@ func (d *Dir) bindDangerousKeys(aa *ui.KeyActions) {
 			Visible:   true,
 			Dangerous: true,
 		}),
+		ui.KeyShiftA: ui.NewKeyActionWithOpts("Apply Prune", d.applyPruneCmd, ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		}),
 		ui.KeyD: ui.NewKeyActionWithOpts("Delete", d.delCmd, ui.ActionOpts{
 			Visible:   true,
 			Dangerous: true,
@@ -199,12 +208,7 @@ func containsDir(sel string) bool {
 	return false
 }
 
-func (d *Dir) applyCmd(evt *tcell.EventKey) *tcell.EventKey {
-	sel := d.GetTable().GetSelectedItem()
-	if sel == "" {
-		return evt
-	}
-
+func applyOpts(sel string) []string {
 	opts := []string{"-f"}
 	if containsDir(sel) {
 		opts = append(opts, "-R")
@@ -212,27 +216,155 @@ func (d *Dir) applyCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if isKustomized(sel) {
 		opts = []string{"-k"}
 	}
+
+	return opts
+}
+
+func (d *Dir) applyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := d.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
 	d.Stop()
 	defer d.Start()
-	{
-		args := make([]string, 0, 10)
-		args = append(args, "apply")
-		args = append(args, opts...)
-		args = append(args, sel)
-		res, err := runKu(context.Background(), d.App(), &shellOpts{clear: false, args: args})
+	d.previewApply(applyOpts(sel), sel)
+
+	return nil
+}
+
+func (d *Dir) applyPruneCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := d.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	d.Stop()
+	defer d.Start()
+	d.showPruneDialog(sel)
+
+	return nil
+}
+
+// showPruneDialog prompts for the label selector scoping the objects to prune.
+func (d *Dir) showPruneDialog(sel string) {
+	styles := d.App().Styles.Dialog()
+	f := tview.NewForm().
+		SetItemPadding(0).
+		SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+
+	var selector string
+	f.AddInputField("Selector:", "", 40, nil, func(changed string) {
+		selector = strings.TrimSpace(changed)
+	})
+	f.AddButton("OK", func() {
+		opts, err := pruneOpts(applyOpts(sel), selector)
 		if err != nil {
-			res = "status:\n  " + err.Error() + "\nmessage:\n" + fmtResults(res)
-		} else {
-			res = "message:\n" + fmtResults(res)
+			d.App().Flash().Err(err)
+			return
 		}
+		d.App().Content.RemovePage(pruneDialogKey)
+		d.Stop()
+		defer d.Start()
+		d.previewApply(opts, sel)
+	})
+	f.AddButton("Cancel", func() {
+		d.App().Content.RemovePage(pruneDialogKey)
+	})
+	for i := range f.GetButtonCount() {
+		f.GetButton(i).
+			SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color()).
+			SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+	}
 
-		details := NewDetails(d.App(), "Applied Manifest", sel, contentYAML, true).Update(res)
+	modal := tview.NewModalForm("<Apply Prune>", f)
+	modal.SetText(fmt.Sprintf("Prune objects missing from %s matching:", sel))
+	modal.SetTextColor(styles.FgColor.Color())
+	modal.SetDoneFunc(func(int, string) {
+		d.App().Content.RemovePage(pruneDialogKey)
+	})
+	d.App().Content.AddPage(pruneDialogKey, modal, false, false)
+	d.App().Content.ShowPage(pruneDialogKey)
+}
+
+// pruneOpts adds pruning to the apply options. Pruning requires a label selector.
+func pruneOpts(opts []string, selector string) ([]string, error) {
+	if selector == "" {
+		return nil, errors.New("a label selector is required to prune")
+	}
+	if _, err := labels.Parse(selector); err != nil {
+		return nil, fmt.Errorf("invalid label selector %q: %w", selector, err)
+	}
+
+	return append(slices.Clone(opts), "--prune", "-l", selector), nil
+}
+
+// previewApply shows a dry-run diff of the apply and applies the manifests once confirmed.
+func (d *Dir) previewApply(opts []string, sel string) {
+	raw, err := d.dryRunDiff(opts, sel)
+	if err != nil {
+		res := "status:\n  " + err.Error() + "\nmessage:\n" + fmtResults(raw)
+		details := NewDetails(d.App(), "Apply Dry Run", sel, contentYAML, true).Update(res)
 		if err := d.App().inject(details, false); err != nil {
 			d.App().Flash().Err(err)
 		}
+		return
 	}
 
-	return nil
+	summary, listing := summarizeChanges(parseDiffChanges(raw))
+	preview := "No changes detected"
+	if raw != "" {
+		preview = tview.Escape(listing) + "\n\n" + colorizeDiff(d.App().Styles.Views().Diff, raw)
+	}
+	dlg := d.App().Styles.Dialog()
+	dialog.ShowPreview(&dlg, d.App().Content.Pages, "Confirm Apply", fmt.Sprintf("Apply %s (%s)?", sel, summary), preview, "Apply", func() {
+		d.apply(opts, sel)
+	}, func() {})
+}
+
+// dryRunDiff previews the changes a manifest apply would incur using a server side dry run.
+func (d *Dir) dryRunDiff(opts []string, sel string) (string, error) {
+	args := make([]string, 0, 10)
+	args = append(args, "diff")
+	args = append(args, opts...)
+	args = append(args, sel)
+	res, err := runKu(context.Background(), d.App(), &shellOpts{
+		clear: false,
+		args:  args,
+		env:   []string{"KUBECTL_EXTERNAL_DIFF=diff -u -N"},
+	})
+	// kubectl diff exits with 1 when differences are found.
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+		return res, nil
+	}
+
+	return res, err
+}
+
+func (d *Dir) apply(opts []string, sel string) {
+	d.Stop()
+	defer d.Start()
+
+	args := make([]string, 0, 10)
+	args = append(args, "apply")
+	args = append(args, opts...)
+	args = append(args, sel)
+	res, err := runKu(context.Background(), d.App(), &shellOpts{clear: false, args: args})
+	if err != nil {
+		res = "status:\n  " + err.Error() + "\nmessage:\n" + fmtResults(res)
+	} else {
+		res = "message:\n" + fmtResults(res)
+	}
+
+	details := NewDetails(d.App(), "Applied Manifest", sel, contentYAML, true).Update(res)
+	if err := d.App().inject(details, false); err != nil {
+		d.App().Flash().Err(err)
+	}
 }
 
 func (d *Dir) delCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -45,3 +45,30 @@ func TestIsKustomized(t *testing.T) {
 		})
 	}
 }
+
+func TestPruneOpts(t *testing.T) {
+	uu := map[string]struct {
+		selector string
+		e        []string
+		err      bool
+	}{
+		"none":    {err: true},
+		"toast":   {selector: "app in (fred", err: true},
+		"labeled": {selector: "app=fred,env!=dev", e: []string{"-f", "--prune", "-l", "app=fred,env!=dev"}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			opts := []string{"-f"}
+			oo, err := pruneOpts(opts, u.selector)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, oo)
+			assert.Equal(t, []string{"-f"}, opts)
+		})
+	}
+}
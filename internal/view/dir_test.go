@@ -16,5 +16,5 @@ func TestDir(t *testing.T) {
 
 	require.NoError(t, v.Init(makeCtx(t)))
 	assert.Equal(t, "Directory", v.Name())
-	assert.Len(t, v.Hints(), 9)
+	assert.Len(t, v.Hints(), 10)
 }
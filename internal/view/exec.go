@@ -49,6 +49,7 @@ type shellOpts struct {
 	binary            string
 	banner            string
 	args              []string
+	env               []string
 }
 
 func (s shellOpts) String() string {
@@ -291,6 +292,9 @@ func oneShoot(ctx context.Context, opts *shellOpts) (string, error) {
 		slogs.Args, strings.Join(opts.args, " "),
 	)
 	cmd := exec.CommandContext(ctx, opts.binary, opts.args...)
+	if len(opts.env) > 0 {
+		cmd.Env = append(os.Environ(), opts.env...)
+	}
 
 	var err error
 	buff := bytes.NewBufferString("")
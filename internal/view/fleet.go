@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/derailed/tcell/v2"
+)
+
+// Fleet represents a resource view aggregated across several contexts.
+type Fleet struct {
+	ResourceViewer
+
+	contexts []string
+	fleet    *watch.Fleet
+}
+
+// NewFleet returns a new fleet view for the given contexts.
+func NewFleet(gvr *client.GVR, contexts []string) ResourceViewer {
+	f := Fleet{
+		ResourceViewer: NewBrowser(gvr),
+		contexts:       contexts,
+	}
+	f.GetTable().SetEnterFn(f.showYAML)
+	f.AddBindKeysFn(f.bindKeys)
+
+	return &f
+}
+
+// Init initializes the view.
+func (f *Fleet) Init(ctx context.Context) error {
+	app, err := extractApp(ctx)
+	if err != nil {
+		return err
+	}
+	if app.Conn() == nil {
+		return errors.New("no connection available")
+	}
+	cc, err := f.resolveContexts(app)
+	if err != nil {
+		return err
+	}
+	f.fleet = watch.NewFleet(app.Conn().Config())
+	ff := f.factoriesFor(app, cc)
+	if len(ff) == 0 {
+		return fmt.Errorf("no reachable contexts in %v", cc)
+	}
+	f.GetTable().SetModel(model.NewFleetTable(f.GVR(), ff))
+
+	return f.ResourceViewer.Init(ctx)
+}
+
+// Start starts the view updates.
+func (f *Fleet) Start() {
+	if f.fleet != nil {
+		f.fleet.Start(client.CleanseNamespace(f.App().Config.ActiveNamespace()))
+	}
+	f.ResourceViewer.Start()
+}
+
+// Stop terminates the view updates.
+func (f *Fleet) Stop() {
+	f.ResourceViewer.Stop()
+	if f.fleet != nil {
+		f.fleet.Terminate()
+	}
+}
+
+func (f *Fleet) resolveContexts(app *App) ([]string, error) {
+	if len(f.contexts) != 1 || f.contexts[0] != cmd.AllContexts {
+		return f.contexts, nil
+	}
+	mm, err := app.Conn().Config().ContextNames()
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Sorted(maps.Keys(mm)), nil
+}
+
+func (f *Fleet) factoriesFor(app *App, cc []string) map[string]dao.Factory {
+	var (
+		ff = make(map[string]dao.Factory, len(cc))
+		mx sync.Mutex
+		wg sync.WaitGroup
+	)
+	for _, ct := range cc {
+		if ct == app.Config.ActiveContextName() && app.factory != nil {
+			ff[ct] = app.factory
+			continue
+		}
+		wg.Add(1)
+		go func(ct string) {
+			defer wg.Done()
+			fac, err := f.fleet.FactoryFor(ct)
+			if err != nil {
+				slog.Warn("Fleet context skipped", slogs.Context, ct, slogs.Error, err)
+				app.Flash().Warnf("Skipping context %q: %s", ct, err)
+				return
+			}
+			mx.Lock()
+			ff[ct] = fac
+			mx.Unlock()
+		}(ct)
+	}
+	wg.Wait()
+
+	return ff
+}
+
+func (f *Fleet) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyE, ui.KeyD, ui.KeyShiftD, tcell.KeyCtrlD, tcell.KeyCtrlK)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyY:      ui.NewKeyAction(yamlAction, f.yamlCmd, true),
+		ui.KeyShiftX: ui.NewKeyAction("Sort Context", f.GetTable().SortColCmd(model.ContextCol, true), false),
+	})
+}
+
+func (f *Fleet) yamlCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := f.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	f.showYAML(f.App(), f.GetTable().GetModel(), f.GVR(), path)
+
+	return nil
+}
+
+func (*Fleet) showYAML(app *App, m ui.Tabular, _ *client.GVR, path string) {
+	o, err := m.Get(context.Background(), path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	raw, err := dao.ToYAML(o, false)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	details := NewDetails(app, yamlAction, path, contentYAML, true).Update(raw)
+	if err := app.inject(details, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
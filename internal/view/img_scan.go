@@ -4,13 +4,23 @@
 package view
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"log/slog"
+	"path/filepath"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/k9s/internal/vul"
 	"github.com/derailed/tcell/v2"
 )
 
@@ -22,9 +32,20 @@ const (
 	ghsaURL      = "https://github.com/advisories/"
 )
 
+const (
+	scanScopeResource  = "Resource"
+	scanScopeNamespace = "Namespace"
+	scanScopeCluster   = "Cluster"
+	scanExportAll      = "All"
+	scanExportTimeout  = 10 * time.Minute
+)
+
 // ImageScan represents an image vulnerability scan view.
 type ImageScan struct {
 	ResourceViewer
+
+	subject    string
+	subjectGVR *client.GVR
 }
 
 // NewImageScan returns a new scans view.
@@ -41,6 +62,11 @@ func NewImageScan(gvr *client.GVR) ResourceViewer {
 // Name returns the component name.
 func (*ImageScan) Name() string { return imgScanTitle }
 
+// SetSubject sets the resource the scans are listed for.
+func (i *ImageScan) SetSubject(gvr *client.GVR, path string) {
+	i.subjectGVR, i.subject = gvr, path
+}
+
 func (i *ImageScan) bindKeys(aa *ui.KeyActions) {
 	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, ui.KeyShiftS, tcell.KeyCtrlZ, tcell.KeyCtrlW)
 	aa.Bulk(ui.KeyMap{
@@ -48,7 +74,101 @@ func (i *ImageScan) bindKeys(aa *ui.KeyActions) {
 		ui.KeyShiftS: ui.NewKeyAction("Sort Severity", i.GetTable().SortColCmd("SEVERITY", false), true),
 		ui.KeyShiftF: ui.NewKeyAction("Sort Fixed-in", i.GetTable().SortColCmd("FIXED-IN", false), true),
 		ui.KeyShiftV: ui.NewKeyAction("Sort Vulnerability", i.GetTable().SortColCmd("VULNERABILITY", false), true),
+		ui.KeyShiftE: ui.NewKeyAction("Export", i.exportCmd, true),
+	})
+}
+
+func (i *ImageScan) exportCmd(*tcell.EventKey) *tcell.EventKey {
+	if vul.ImgScanner == nil || !vul.ImgScanner.IsInitialized() {
+		i.App().Flash().Warn("Image scanner is not initialized")
+		return nil
+	}
+
+	scopes := make([]string, 0, 3)
+	if i.subject != "" {
+		scopes = append(scopes, scanScopeResource)
+	}
+	scopes = append(scopes, scanScopeNamespace, scanScopeCluster)
+	formats := make([]string, 0, len(vul.ExportFormats)+1)
+	for _, f := range vul.ExportFormats {
+		formats = append(formats, string(f))
+	}
+	formats = append(formats, scanExportAll)
+
+	d := i.App().Styles.Dialog()
+	dialog.ShowSelection(&d, i.App().Content.Pages, "Export Scope", scopes, func(si int) {
+		if si < 0 {
+			return
+		}
+		dialog.ShowSelection(&d, i.App().Content.Pages, "Export Format", formats, func(fi int) {
+			if fi < 0 {
+				return
+			}
+			ff := vul.ExportFormats
+			if formats[fi] != scanExportAll {
+				ff = []vul.ExportFormat{vul.ExportFormat(formats[fi])}
+			}
+			i.export(scopes[si], ff)
+		})
 	})
+
+	return nil
+}
+
+func (i *ImageScan) export(scope string, ff []vul.ExportFormat) {
+	ns, _ := client.Namespaced(i.subject)
+	if ns == "" {
+		ns = i.App().Config.ActiveNamespace()
+	}
+	name := "scans-" + strings.ToLower(scope)
+	switch scope {
+	case scanScopeResource:
+		name += "-" + i.subject
+	case scanScopeNamespace:
+		name += "-" + ns
+	case scanScopeCluster:
+		ns = client.BlankNamespace
+	}
+	dir := filepath.Join(i.App().Config.K9s.ContextScreenDumpDir(), data.SanitizeFileName(fmt.Sprintf("%s-%d", name, time.Now().Unix())))
+
+	i.App().Flash().Infof("Exporting %s scans...", strings.ToLower(scope))
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), scanExportTimeout)
+		defer cancel()
+
+		files, err := exportScans(ctx, i.App().factory, i.subjectGVR, i.subject, scope, ns, dir, ff)
+		i.App().QueueUpdateDraw(func() {
+			switch {
+			case len(files) == 0 && err != nil:
+				i.App().Flash().Err(err)
+			case err != nil:
+				slog.Warn("Scans export incomplete", slogs.Error, err)
+				i.App().Flash().Warnf("Exported %d scan files to %s with errors. Check the logs", len(files), dir)
+			default:
+				i.App().Flash().Infof("Exported %d scan files to %s", len(files), dir)
+			}
+		})
+	}()
+}
+
+func exportScans(ctx context.Context, f dao.Factory, gvr *client.GVR, path, scope, ns, dir string, ff []vul.ExportFormat) ([]string, error) {
+	var (
+		ii  []string
+		err error
+	)
+	if scope == scanScopeResource {
+		ii, err = dao.ListImages(ctx, f, gvr, path)
+	} else {
+		ii, err = dao.NamespaceImages(f, ns)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(ii) == 0 {
+		return nil, fmt.Errorf("no images found for %s scope", strings.ToLower(scope))
+	}
+
+	return vul.ImgScanner.Export(ctx, dir, ii, ff...)
 }
 
 func (*ImageScan) viewCVE(app *App, _ ui.Tabular, _ *client.GVR, path string) {
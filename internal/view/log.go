@@ -73,6 +73,7 @@ func (l *Log) Init(ctx context.Context) (err error) {
 		return err
 	}
 	l.model.Configure(l.app.Config.K9s.Logger)
+	l.model.SetHighlighter(loadLogHighlighter(l.app))
 
 	l.SetBorder(true)
 	l.SetDirection(tview.FlexRow)
@@ -150,6 +151,9 @@ func (l *Log) LogCleared() {
 func (l *Log) LogFailed(err error) {
 	l.app.QueueUpdateDraw(func() {
 		l.app.Flash().Err(err)
+		if l.indicator.Recording() != l.model.IsRecording() {
+			l.indicator.SetRecording(l.model.IsRecording())
+		}
 		if l.logs.GetText(true) == logMessage {
 			l.logs.Clear()
 		}
@@ -234,6 +238,11 @@ func (l *Log) Start() {
 
 // Stop terminates the component.
 func (l *Log) Stop() {
+	if path, err := l.model.StopRecording(); err != nil {
+		slog.Error("Log recording close failed", slogs.Error, err)
+	} else if path != "" {
+		l.app.Flash().Infof("Log recording saved to %s", path)
+	}
 	l.model.RemoveListener(l)
 	l.model.Stop()
 	l.cancel()
@@ -254,6 +263,9 @@ func (l *Log) bindKeys() {
 		ui.Key4:         ui.NewKeyAction("15m", l.sinceCmd(15*60), true),
 		ui.Key5:         ui.NewKeyAction("30m", l.sinceCmd(30*60), true),
 		ui.Key6:         ui.NewKeyAction("1h", l.sinceCmd(60*60), true),
+		ui.KeyShiftR:    ui.NewKeyAction("Time Range", l.rangeCmd, true),
+		ui.KeyB:         ui.NewKeyAction("Older", l.pageBackCmd, true),
+		ui.KeyN:         ui.NewKeyAction("Newer", l.pageForwardCmd, true),
 		tcell.KeyEnter:  ui.NewSharedKeyAction("Filter", l.filterCmd, false),
 		tcell.KeyEscape: ui.NewKeyAction("Back", l.resetCmd, false),
 		ui.KeyQ:         ui.NewKeyAction("Back", l.resetCmd, false),
@@ -263,7 +275,10 @@ func (l *Log) bindKeys() {
 		ui.KeyShiftL:    ui.NewKeyAction("Toggle ColumnLock", l.toggleColumnLockCmd, true),
 		ui.KeyF:         ui.NewKeyAction("Toggle FullScreen", l.toggleFullScreenCmd, true),
 		ui.KeyT:         ui.NewKeyAction("Toggle Timestamp", l.toggleTimestampCmd, true),
+		ui.KeyShiftJ:    ui.NewKeyAction("Toggle Structured", l.toggleStructuredCmd, true),
+		ui.KeyR:         ui.NewKeyAction("Toggle Record", l.toggleRecordCmd, true),
 		ui.KeyW:         ui.NewKeyAction("Toggle Wrap", l.toggleTextWrapCmd, true),
+		ui.KeyShiftP:    ui.NewKeyAction("Patterns", l.patternsCmd, true),
 		tcell.KeyCtrlS:  ui.NewKeyAction("Save", l.SaveCmd, true),
 		ui.KeyC:         ui.NewKeyAction("Copy", cpCmd(l.app.Flash(), l.logs.TextView), true),
 	})
@@ -315,6 +330,9 @@ func (l *Log) updateTitle() {
 	if l.model.IsHead() {
 		since = "head"
 	}
+	if start, end := l.model.Range(); !start.IsZero() {
+		since = rangeTitle(start, end, time.Now())
+	}
 
 	title := " Logs"
 	if l.model.LogOptions().Previous {
@@ -394,6 +412,52 @@ func (l *Log) sinceCmd(n int) func(evt *tcell.EventKey) *tcell.EventKey {
 	}
 }
 
+func (l *Log) rangeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.app.InCmdMode() {
+		return evt
+	}
+
+	start, end := l.model.Range()
+	ShowLogRange(l.app, start, end, func(start, end time.Time) {
+		l.logs.Clear()
+		l.model.SetRange(l.getContext(), start, end)
+		l.requestOneRefresh = true
+		l.updateTitle()
+	})
+
+	return nil
+}
+
+func (l *Log) pageBackCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.app.InCmdMode() {
+		return evt
+	}
+
+	l.logs.Clear()
+	l.model.PageBack(l.getContext())
+	l.requestOneRefresh = true
+	l.updateTitle()
+
+	return nil
+}
+
+func (l *Log) pageForwardCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.app.InCmdMode() {
+		return evt
+	}
+
+	if start, end := l.model.Range(); start.IsZero() || end.IsZero() {
+		l.app.Flash().Info("Already following the latest logs")
+		return nil
+	}
+	l.logs.Clear()
+	l.model.PageForward(l.getContext())
+	l.requestOneRefresh = true
+	l.updateTitle()
+
+	return nil
+}
+
 func (l *Log) toggleAllContainers(evt *tcell.EventKey) *tcell.EventKey {
 	if l.app.InCmdMode() {
 		return evt
@@ -430,6 +494,72 @@ func (l *Log) SaveCmd(*tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (l *Log) toggleRecordCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.app.InCmdMode() {
+		return evt
+	}
+
+	if l.model.IsRecording() {
+		path, err := l.model.StopRecording()
+		l.indicator.SetRecording(false)
+		if err != nil {
+			l.app.Flash().Err(err)
+			return nil
+		}
+		l.app.Flash().Infof("Log recording saved to %s", path)
+		return nil
+	}
+
+	dir := l.app.Config.K9s.ContextScreenDumpDir()
+	if err := ensureDir(dir); err != nil {
+		l.app.Flash().Err(err)
+		return nil
+	}
+	f := fmt.Sprintf("%s-%d.log", l.model.GetPath(), time.Now().UnixNano())
+	path := filepath.Join(dir, data.SanitizeFileName(f))
+	if err := l.model.StartRecording(path); err != nil {
+		l.app.Flash().Err(err)
+		return nil
+	}
+	l.indicator.SetRecording(true)
+	l.app.Flash().Infof("Recording logs to %s", path)
+
+	return nil
+}
+
+func (l *Log) patternsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.app.InCmdMode() {
+		return evt
+	}
+
+	opts := l.model.LogOptions().Clone()
+	opts.SinceTime = ""
+	if err := l.app.inject(NewLogPattern(l.model.GVR(), opts), false); err != nil {
+		l.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+// loadLogHighlighter loads the global and context log highlight rules.
+func loadLogHighlighter(a *App) *dao.LogHighlighter {
+	hh := config.NewLogHighlights()
+	if err := hh.Load(a.Config.ContextLogHighlightsPath()); err != nil {
+		slog.Warn("Unable to load log highlights", slogs.Error, err)
+		return nil
+	}
+	rr, err := hh.Rules(a.Styles.Views().Log.Highlight)
+	if err != nil {
+		slog.Warn("Log highlights misconfigured", slogs.Error, err)
+	}
+	h, err := dao.NewLogHighlighter(rr)
+	if err != nil {
+		slog.Warn("Log highlights misconfigured", slogs.Error, err)
+	}
+
+	return h
+}
+
 func ensureDir(dir string) error {
 	return os.MkdirAll(dir, 0744)
 }
@@ -490,6 +620,18 @@ func (l *Log) toggleTimestampCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (l *Log) toggleStructuredCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.app.InCmdMode() {
+		return evt
+	}
+
+	l.indicator.ToggleStructured()
+	l.model.ToggleStructured(l.indicator.Structured())
+	l.indicator.Refresh()
+
+	return nil
+}
+
 func (l *Log) toggleTextWrapCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if l.app.InCmdMode() {
 		return evt
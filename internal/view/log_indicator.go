@@ -23,6 +23,8 @@ type LogIndicator struct {
 	fullScreen                 bool
 	textWrap                   bool
 	showTime                   bool
+	structured                 bool
+	recording                  bool
 	allContainers              bool
 	shouldDisplayAllContainers bool
 	columnLock                 bool
@@ -38,6 +40,7 @@ func NewLogIndicator(cfg *config.Config, styles *config.Styles, allContainers bo
 		fullScreen:                 cfg.K9s.UI.DefaultsToFullScreen,
 		textWrap:                   cfg.K9s.Logger.TextWrap,
 		showTime:                   cfg.K9s.Logger.ShowTime,
+		structured:                 cfg.K9s.Logger.Structured,
 		shouldDisplayAllContainers: allContainers,
 		columnLock:                 cfg.K9s.Logger.ColumnLock,
 	}
@@ -76,6 +79,22 @@ func (l *LogIndicator) Timestamp() bool {
 	return l.showTime
 }
 
+// Recording reports the current log recording mode.
+func (l *LogIndicator) Recording() bool {
+	return l.recording
+}
+
+// SetRecording sets the log recording mode.
+func (l *LogIndicator) SetRecording(b bool) {
+	l.recording = b
+	l.Refresh()
+}
+
+// Structured reports the current structured logs mode.
+func (l *LogIndicator) Structured() bool {
+	return l.structured
+}
+
 // TextWrap reports the current wrap mode.
 func (l *LogIndicator) TextWrap() bool {
 	return l.textWrap
@@ -96,6 +115,11 @@ func (l *LogIndicator) ToggleTimestamp() {
 	l.showTime = !l.showTime
 }
 
+// ToggleStructured toggles the structured logs mode.
+func (l *LogIndicator) ToggleStructured() {
+	l.structured = !l.structured
+}
+
 // ToggleFullScreen toggles the screen mode.
 func (l *LogIndicator) ToggleFullScreen() {
 	l.fullScreen = !l.fullScreen
@@ -165,6 +189,18 @@ func (l *LogIndicator) Refresh() {
 		l.indicator = append(l.indicator, fmt.Sprintf(toggleOffFmt, "FullScreen", spacer)...)
 	}
 
+	if l.Recording() {
+		l.indicator = append(l.indicator, fmt.Sprintf(toggleOnFmt, "Record", spacer)...)
+	} else {
+		l.indicator = append(l.indicator, fmt.Sprintf(toggleOffFmt, "Record", spacer)...)
+	}
+
+	if l.Structured() {
+		l.indicator = append(l.indicator, fmt.Sprintf(toggleOnFmt, "Structured", spacer)...)
+	} else {
+		l.indicator = append(l.indicator, fmt.Sprintf(toggleOffFmt, "Structured", spacer)...)
+	}
+
 	if l.Timestamp() {
 		l.indicator = append(l.indicator, fmt.Sprintf(toggleOnFmt, "Timestamps", spacer)...)
 	} else {
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// LogPattern presents a live view of log lines clustered by pattern.
+type LogPattern struct {
+	ResourceViewer
+
+	model *model.Log
+}
+
+// NewLogPattern returns a new viewer streaming logs for the given resource.
+func NewLogPattern(gvr *client.GVR, opts *dao.LogOptions) ResourceViewer {
+	p := LogPattern{
+		ResourceViewer: NewBrowser(client.LpGVR),
+		model:          model.NewLog(gvr, opts, defaultFlushTimeout),
+	}
+	p.GetTable().SetBorderFocusColor(tcell.ColorMediumPurple)
+	p.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumPurple).Attributes(tcell.AttrNone))
+	p.GetTable().SetSortCol("COUNT", false)
+	p.GetTable().SetEnterFn(p.viewLines)
+	p.SetContextFn(p.patternContext)
+	p.AddBindKeysFn(p.bindKeys)
+
+	return &p
+}
+
+// Init initializes the view.
+func (p *LogPattern) Init(ctx context.Context) error {
+	if err := p.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	// Tail the whole buffer so patterns are clustered over as many lines as the logs view holds.
+	cfg := p.App().Config.K9s.Logger
+	cfg.TailCount = int64(cfg.BufferSize)
+	p.model.Configure(cfg)
+	p.model.Init(p.App().factory)
+
+	return nil
+}
+
+// Start starts the logs stream and the view refresh.
+func (p *LogPattern) Start() {
+	p.model.Start(context.Background())
+	p.ResourceViewer.Start()
+}
+
+// Stop terminates the logs stream and the view refresh.
+func (p *LogPattern) Stop() {
+	p.ResourceViewer.Stop()
+	p.model.Stop()
+}
+
+func (p *LogPattern) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlD, tcell.KeyCtrlZ)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftP: ui.NewKeyAction("Sort Pattern", p.GetTable().SortColCmd("PATTERN", true), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Count", p.GetTable().SortColCmd("COUNT", false), false),
+		ui.KeyShiftL: ui.NewKeyAction("Sort Last Seen", p.GetTable().SortColCmd("LAST SEEN", true), false),
+	})
+}
+
+func (p *LogPattern) patternContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyLogItems, p.model.Lines())
+}
+
+func (p *LogPattern) viewLines(app *App, _ ui.Tabular, _ *client.GVR, tpl string) {
+	for _, pat := range p.model.Lines().Patterns() {
+		if pat.Template != tpl {
+			continue
+		}
+		details := NewDetails(app, "Pattern Lines", p.model.LogOptions().Info(), contentTXT, true).
+			Update(strings.Join(pat.Lines, "\n"))
+		if err := app.inject(details, false); err != nil {
+			app.Flash().Err(err)
+		}
+		return
+	}
+	app.Flash().Warnf("No lines matching pattern %q", tpl)
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const (
+	logRangeKey     = "logRange"
+	logRangeInFmt   = "2006-01-02 15:04:05"
+	logRangeTimeFmt = "15:04:05"
+	logRangeDateFmt = "01-02 15:04:05"
+	logRangeHelp    = "Formats: HH:MM[:SS], YYYY-MM-DD HH:MM[:SS] or RFC3339.\nLeave End blank to follow the logs."
+)
+
+// LogRangeCB represents a log time range callback.
+type LogRangeCB func(start, end time.Time)
+
+// ShowLogRange pops a logs time range dialog.
+func ShowLogRange(a *App, start, end time.Time, okFn LogRangeCB) {
+	styles := a.Styles.Dialog()
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color()).
+		SetFieldBackgroundColor(styles.BgColor.Color())
+
+	from, to := formatRangeTime(start, logRangeInFmt), formatRangeTime(end, logRangeInFmt)
+	f.AddInputField("Start:", from, 30, nil, func(s string) {
+		from = s
+	})
+	f.AddInputField("End:", to, 30, nil, func(s string) {
+		to = s
+	})
+	for i := range 2 {
+		if field, ok := f.GetFormItem(i).(*tview.InputField); ok {
+			field.SetLabelColor(styles.LabelFgColor.Color())
+			field.SetFieldTextColor(styles.FieldFgColor.Color())
+			field.SetPlaceholder("02:10")
+		}
+	}
+
+	pages := a.Content.Pages
+	f.AddButton("OK", func() {
+		t1, t2, err := dao.ParseLogRange(from, to, time.Now())
+		if err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		dismissLogRange(a, pages)
+		okFn(t1, t2)
+	})
+	f.AddButton("Cancel", func() {
+		dismissLogRange(a, pages)
+	})
+	for i := range 2 {
+		if b := f.GetButton(i); b != nil {
+			b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+			b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+		}
+	}
+
+	modal := tview.NewModalForm("<Time Range>", f)
+	modal.SetText(logRangeHelp)
+	modal.SetTextColor(styles.FgColor.Color())
+	modal.SetBackgroundColor(styles.BgColor.Color())
+	modal.SetDoneFunc(func(int, string) {
+		dismissLogRange(a, pages)
+	})
+
+	pages.AddPage(logRangeKey, modal, false, true)
+	pages.ShowPage(logRangeKey)
+	a.SetFocus(pages.GetPrimitive(logRangeKey))
+}
+
+func dismissLogRange(a *App, p *ui.Pages) {
+	p.RemovePage(logRangeKey)
+	a.SetFocus(p.CurrentPage().Item)
+}
+
+// rangeTitle returns a compact time range representation ie 02:10:00-02:25:00.
+func rangeTitle(start, end, now time.Time) string {
+	fmat := logRangeTimeFmt
+	if y, m, d := now.Date(); start.Local().Year() != y || start.Local().Month() != m || start.Local().Day() != d {
+		fmat = logRangeDateFmt
+	}
+	to := "now"
+	if !end.IsZero() {
+		to = formatRangeTime(end, fmat)
+	}
+
+	return formatRangeTime(start, fmat) + "-" + to
+}
+
+func formatRangeTime(t time.Time, fmat string) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Local().Format(fmat)
+}
@@ -84,6 +84,7 @@ func (n *Node) bindKeys(aa *ui.KeyActions) {
 
 	aa.Bulk(ui.KeyMap{
 		ui.KeyY: ui.NewKeyAction(yamlAction, n.yamlCmd, true),
+		ui.KeyL: ui.NewKeyAction("Logs", n.logsCmd, true),
 	})
 }
 
@@ -91,6 +92,31 @@ func (n *Node) showPods(a *App, _ ui.Tabular, _ *client.GVR, path string) {
 	showPods(a, n.GetTable().GetSelectedItem(), nil, "spec.nodeName="+path)
 }
 
+func (n *Node) logsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	ShowNodeLogs(n.App(), path, func(src string) {
+		cfg := n.App().Config.K9s.Logger
+		opts := dao.LogOptions{
+			Path:            path,
+			Container:       src,
+			Lines:           cfg.TailCount,
+			SinceSeconds:    cfg.SinceSeconds,
+			SingleContainer: true,
+			ShowTimestamp:   cfg.ShowTime,
+			LogBufferSize:   cfg.LogBufferSize,
+		}
+		if err := n.App().inject(NewLog(n.GVR(), &opts), false); err != nil {
+			n.App().Flash().Err(err)
+		}
+	})
+
+	return nil
+}
+
 func (n *Node) drainCmd(evt *tcell.EventKey) *tcell.EventKey {
 	sels := n.GetTable().GetSelectedItems()
 	if len(sels) == 0 {
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const (
+	nodeLogsKey  = "nodeLogs"
+	nodeLogsHelp = "Pick a journal unit or enter a unit or a file under /var/log ie pods/fred.log.\nRequires the kubelet NodeLogQuery feature."
+)
+
+var nodeLogUnits = []string{"kubelet", "containerd", "crio", "docker", "kube-proxy"}
+
+// NodeLogsCB represents a node log source selection callback.
+type NodeLogsCB func(src string)
+
+// ShowNodeLogs pops a node log source dialog.
+func ShowNodeLogs(a *App, node string, okFn NodeLogsCB) {
+	styles := a.Styles.Dialog()
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color()).
+		SetFieldBackgroundColor(styles.BgColor.Color())
+
+	unit, custom := nodeLogUnits[0], ""
+	f.AddDropDown("Unit:", nodeLogUnits, 0, func(s string, _ int) {
+		unit = s
+	})
+	if dd, ok := f.GetFormItemByLabel("Unit:").(*tview.DropDown); ok {
+		dd.SetListStyles(
+			styles.FgColor.Color(), styles.BgColor.Color(),
+			styles.ButtonFocusFgColor.Color(), styles.ButtonFocusBgColor.Color(),
+		)
+	}
+	f.AddInputField("Custom:", "", 30, nil, func(s string) {
+		custom = s
+	})
+	if field, ok := f.GetFormItemByLabel("Custom:").(*tview.InputField); ok {
+		field.SetLabelColor(styles.LabelFgColor.Color())
+		field.SetFieldTextColor(styles.FieldFgColor.Color())
+	}
+
+	pages := a.Content.Pages
+	f.AddButton("OK", func() {
+		src := unit
+		if s := strings.TrimSpace(custom); s != "" {
+			src = s
+		}
+		dismissNodeLogs(a, pages)
+		okFn(src)
+	})
+	f.AddButton("Cancel", func() {
+		dismissNodeLogs(a, pages)
+	})
+	for i := range 2 {
+		if b := f.GetButton(i); b != nil {
+			b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+			b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+		}
+	}
+
+	modal := tview.NewModalForm("<Node Logs "+node+">", f)
+	modal.SetText(nodeLogsHelp)
+	modal.SetTextColor(styles.FgColor.Color())
+	modal.SetBackgroundColor(styles.BgColor.Color())
+	modal.SetDoneFunc(func(int, string) {
+		dismissNodeLogs(a, pages)
+	})
+
+	pages.AddPage(nodeLogsKey, modal, false, true)
+	pages.ShowPage(nodeLogsKey)
+	a.SetFocus(pages.GetPrimitive(nodeLogsKey))
+}
+
+func dismissNodeLogs(a *App, p *ui.Pages) {
+	p.RemovePage(nodeLogsKey)
+	a.SetFocus(p.CurrentPage().Item)
+}
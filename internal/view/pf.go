@@ -129,6 +129,7 @@ func (p *PortForward) runBenchmark() error {
 		return err
 	}
 	name := p.App().Config.K9s.ActiveContextName()
+	go trackBenchProgress(p.App(), p.bench)
 	p.bench.Run(ct.ClusterName, name, func() {
 		slog.Debug("Benchmark Completed!", slogs.Name, name)
 		p.App().QueueUpdate(func() {
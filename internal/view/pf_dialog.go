@@ -10,6 +10,8 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/port"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
@@ -63,7 +65,12 @@ func ShowPortForwards(v ResourceViewer, path string, ports port.ContainerPortSpe
 	f.AddInputField("Address:", address, fieldLen, nil, func(h string) {
 		address = h
 	})
-	for i := range 3 {
+	var profile string
+	f.AddInputField("Profile:", "", fieldLen, nil, func(h string) {
+		profile = h
+	})
+	f.GetFormItemByLabel("Profile:").(*tview.InputField).SetPlaceholder("Optional profile name to save to")
+	for i := range 4 {
 		if field, ok := f.GetFormItem(i).(*tview.InputField); ok {
 			field.SetLabelColor(styles.LabelFgColor.Color())
 			field.SetFieldTextColor(styles.FieldFgColor.Color())
@@ -82,6 +89,13 @@ func ShowPortForwards(v ResourceViewer, path string, ports port.ContainerPortSpe
 		}
 		if err := okFn(v, path, tt); err != nil {
 			v.App().Flash().Err(err)
+			return
+		}
+		if profile == "" {
+			return
+		}
+		if err := savePFProfile(v, path, profile, tt); err != nil {
+			v.App().Flash().Err(err)
 		}
 	})
 	pages := v.App().Content.Pages
@@ -121,6 +135,48 @@ func DismissPortForwards(v ResourceViewer, p *ui.Pages) {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func savePFProfile(v ResourceViewer, path, profile string, tt port.PortTunnels) error {
+	gvr, target := pfTarget(v, path)
+	ff := make([]config.PFProfileForward, 0, len(tt))
+	for _, t := range tt {
+		f := config.PFProfileForward{
+			Path:          target,
+			Container:     t.Container,
+			ContainerPort: t.ContainerPort,
+			LocalPort:     t.LocalPort,
+		}
+		if gvr != client.PodGVR {
+			f.GVR = gvr.String()
+		}
+		if t.Address != v.App().Config.K9s.PortForwardAddress {
+			f.Address = t.Address
+		}
+		ff = append(ff, f)
+	}
+
+	file := v.App().Config.ContextPFProfilesPath()
+	pp := config.NewPFProfiles()
+	if err := pp.Load(file); err != nil {
+		return err
+	}
+	pp.Add(profile, ff...)
+
+	return pp.Save(file)
+}
+
+// pfTarget returns the most stable forward target for the current view
+// so profiles survive pods being rescheduled.
+func pfTarget(v ResourceViewer, path string) (*client.GVR, string) {
+	switch v.GVR() {
+	case client.DpGVR, client.StsGVR, client.DsGVR, client.SvcGVR:
+		if sel := v.GetTable().GetSelectedItem(); sel != "" {
+			return v.GVR(), sel
+		}
+	}
+
+	return client.PodGVR, path
+}
+
 func extractPort(p string) string {
 	tokens := strings.Split(p, "::")
 	if len(tokens) < 2 {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -24,6 +25,11 @@ import (
 	"k8s.io/client-go/tools/portforward"
 )
 
+const (
+	pfReconnectRetries = 10
+	pfReconnectDelay   = 2 * time.Second
+)
+
 // PortForwardExtender adds port-forward extensions.
 type PortForwardExtender struct {
 	ResourceViewer
@@ -135,27 +141,75 @@ func runForward(v ResourceViewer, pf watch.Forwarder, f *portforward.PortForward
 		DismissPortForwards(v, v.App().Content.Pages)
 	})
 
+	forwardPorts(v.App(), pf, f)
+}
+
+func forwardPorts(a *App, pf watch.Forwarder, f *portforward.PortForwarder) {
 	pf.SetActive(true)
-	if err := f.ForwardPorts(); err != nil {
-		v.App().Flash().Warnf("PortForward failed for %s: %s. Deleting!", pf.ID(), err)
+	for {
+		err := f.ForwardPorts()
+		if err == nil {
+			break
+		}
+		fpf, ok := pf.(*dao.PortForwarder)
+		if !ok || !fpf.IsFollowing() || fpf.IsStopped() {
+			a.Flash().Warnf("PortForward failed for %s: %s. Deleting!", pf.ID(), err)
+			break
+		}
+		if f = reconnectForward(a, fpf, err); f == nil {
+			break
+		}
 	}
-	v.App().QueueUpdateDraw(func() {
-		v.App().factory.DeleteForwarder(pf.ID())
+	a.QueueUpdateDraw(func() {
+		a.factory.DeleteForwarder(pf.ID())
 		pf.SetActive(false)
 	})
 }
 
+// reconnectForward attempts to reconnect a following port-forward to a ready pod.
+func reconnectForward(a *App, pf *dao.PortForwarder, cause error) *portforward.PortForwarder {
+	oldID := pf.ID()
+	slog.Debug("Port forward lost connection. Reconnecting",
+		slogs.PFID, oldID,
+		slogs.Error, cause,
+	)
+	for range pfReconnectRetries {
+		time.Sleep(pfReconnectDelay)
+		if pf.IsStopped() {
+			return nil
+		}
+		f, err := pf.Reconnect()
+		if err != nil {
+			slog.Debug("Port forward reconnect failed",
+				slogs.PFID, oldID,
+				slogs.Error, err,
+			)
+			continue
+		}
+		a.factory.ReplaceForwarder(oldID, pf)
+		a.Flash().Infof("PortForward %s reconnected to %s", pf.Target(), pf.Path())
+		return f
+	}
+	a.Flash().Warnf("PortForward failed for %s: %s. Deleting!", oldID, cause)
+
+	return nil
+}
+
 func startFwdCB(v ResourceViewer, path string, pts port.PortTunnels) error {
 	if err := pts.CheckAvailable(context.Background()); err != nil {
 		return err
 	}
 
+	gvr, target := pfTarget(v, path)
 	tt := make([]string, 0, len(pts))
 	for _, pt := range pts {
 		if _, ok := v.App().factory.ForwarderFor(dao.PortForwardID(path, pt.Container, pt.PortMap())); ok {
 			return fmt.Errorf("port-forward is already active on pod %s", path)
 		}
 		pf := dao.NewPortForwarder(v.App().factory)
+		if gvr != client.PodGVR {
+			pf.Follow(gvr, target)
+		}
 		fwd, err := pf.Start(path, pt)
 		if err != nil {
 			return err
@@ -0,0 +1,247 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/port"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+// PFProfile presents a port-forward profiles viewer.
+type PFProfile struct {
+	ResourceViewer
+}
+
+// NewPFProfile returns a new viewer.
+func NewPFProfile(gvr *client.GVR) ResourceViewer {
+	p := PFProfile{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.GetTable().SetBorderFocusColor(tcell.ColorDodgerBlue)
+	p.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorDodgerBlue).Attributes(tcell.AttrNone))
+	p.GetTable().SetSortCol("NAME", true)
+	p.GetTable().SetEnterFn(p.toggleProfile)
+	p.SetContextFn(p.profileContext)
+	p.AddBindKeysFn(p.bindKeys)
+
+	return &p
+}
+
+func (p *PFProfile) profileContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyPFProfiles, p.App().Config.ContextPFProfilesPath())
+
+	return context.WithValue(ctx, internal.KeyPFAddress, p.App().Config.K9s.PortForwardAddress)
+}
+
+func (p *PFProfile) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlW, tcell.KeyCtrlZ)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyS:        ui.NewKeyAction("Start", p.startCmd, true),
+		ui.KeyX:        ui.NewKeyAction("Stop", p.stopCmd, true),
+		ui.KeyA:        ui.NewKeyAction("Toggle AutoStart", p.toggleAutoStartCmd, true),
+		tcell.KeyCtrlD: ui.NewKeyAction("Delete", p.deleteCmd, true),
+		ui.KeyShiftN:   ui.NewKeyAction("Sort Name", p.GetTable().SortColCmd("NAME", true), false),
+	})
+}
+
+func (p *PFProfile) toggleProfile(app *App, _ ui.Tabular, _ *client.GVR, name string) {
+	pr, err := loadPFProfile(app, name)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if stopPFProfile(app, &pr) > 0 {
+		app.Flash().Infof("PortForward profile %q stopped", name)
+		p.Refresh()
+		return
+	}
+	p.start(&pr)
+}
+
+func (p *PFProfile) startCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := p.GetTable().GetSelectedItem()
+	if name == "" {
+		return evt
+	}
+	pr, err := loadPFProfile(p.App(), name)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	p.start(&pr)
+
+	return nil
+}
+
+func (p *PFProfile) start(pr *config.PFProfile) {
+	go func() {
+		n, err := startPFProfile(context.Background(), p.App(), pr)
+		if err != nil {
+			p.App().Flash().Err(err)
+			return
+		}
+		p.App().Flash().Infof("PortForward profile %q started (%d forwards)", pr.Name, n)
+	}()
+}
+
+func (p *PFProfile) stopCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := p.GetTable().GetSelectedItem()
+	if name == "" {
+		return evt
+	}
+	pr, err := loadPFProfile(p.App(), name)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	n := stopPFProfile(p.App(), &pr)
+	p.App().Flash().Infof("PortForward profile %q stopped (%d forwards)", name, n)
+	p.Refresh()
+
+	return nil
+}
+
+func (p *PFProfile) toggleAutoStartCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := p.GetTable().GetSelectedItem()
+	if name == "" {
+		return evt
+	}
+	path := p.App().Config.ContextPFProfilesPath()
+	pp := config.NewPFProfiles()
+	if err := pp.Load(path); err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	on, err := pp.ToggleAutoStart(name)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	if err := pp.Save(path); err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	p.App().Flash().Infof("PortForward profile %q autostart set to %t", name, on)
+	p.Refresh()
+
+	return nil
+}
+
+func (p *PFProfile) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := p.GetTable().GetSelectedItem()
+	if name == "" {
+		return evt
+	}
+
+	d := p.App().Styles.Dialog()
+	dialog.ShowConfirm(&d, p.App().Content.Pages, "Delete", fmt.Sprintf("Delete port-forward profile %q?", name), func() {
+		path := p.App().Config.ContextPFProfilesPath()
+		pp := config.NewPFProfiles()
+		if err := pp.Load(path); err != nil {
+			p.App().Flash().Err(err)
+			return
+		}
+		if err := pp.Delete(name); err != nil {
+			p.App().Flash().Err(err)
+			return
+		}
+		if err := pp.Save(path); err != nil {
+			p.App().Flash().Err(err)
+			return
+		}
+		p.Refresh()
+	}, func() {})
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func loadPFProfile(a *App, name string) (config.PFProfile, error) {
+	pp := config.NewPFProfiles()
+	if err := pp.Load(a.Config.ContextPFProfilesPath()); err != nil {
+		return config.PFProfile{}, err
+	}
+	pr, ok := pp.Get(name)
+	if !ok {
+		return pr, fmt.Errorf("no port-forward profile named %q", name)
+	}
+
+	return pr, nil
+}
+
+// startPFProfile starts all inactive forwards for a given profile.
+func startPFProfile(ctx context.Context, a *App, pr *config.PFProfile) (int, error) {
+	var (
+		errs  error
+		count int
+	)
+	for _, f := range pr.Forwards {
+		if err := ctx.Err(); err != nil {
+			return count, errors.Join(errs, err)
+		}
+		if _, ok := dao.PFProfileForwarder(a.factory.Forwarders(), f, a.Config.K9s.PortForwardAddress); ok {
+			continue
+		}
+		path, err := dao.ResolvePFTarget(a.factory, f)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		if err := ensurePodPortFwdAllowed(a.factory, path); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", path, err))
+			continue
+		}
+		pt := f.Tunnel(a.Config.K9s.PortForwardAddress)
+		if err := (port.PortTunnels{pt}).CheckAvailable(ctx); err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		pf := dao.NewPortForwarder(a.factory)
+		if gvr := f.TargetGVR(); gvr != client.PodGVR {
+			pf.Follow(gvr, f.Path)
+		}
+		fwd, err := pf.Start(path, pt)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		slog.Debug("Starting profile port forward",
+			slogs.Name, pr.Name,
+			slogs.PFID, pf.ID(),
+			slogs.PFTunnel, pt,
+		)
+		a.factory.AddForwarder(pf)
+		go forwardPorts(a, pf, fwd)
+		count++
+	}
+
+	return count, errs
+}
+
+// stopPFProfile stops all active forwards for a given profile.
+func stopPFProfile(a *App, pr *config.PFProfile) int {
+	var count int
+	for _, f := range pr.Forwards {
+		if fwd, ok := dao.PFProfileForwarder(a.factory.Forwarders(), f, a.Config.K9s.PortForwardAddress); ok {
+			a.factory.DeleteForwarder(fwd.ID())
+			count++
+		}
+	}
+
+	return count
+}
@@ -145,6 +145,9 @@ func (p *Pulse) Init(ctx context.Context) error {
 	p.app.Styles.AddListener(p)
 	p.StylesChanged(p.app.Styles)
 	p.model.SetNamespace(ns)
+	if mh := p.app.Config.K9s.MetricsHistory; mh.Enable {
+		p.model.SetMetricsStore(dao.NewMetricsStore(p.app.Config.K9s.ContextMetricsHistoryFile(), mh.RetentionDuration()))
+	}
 
 	return nil
 }
@@ -283,9 +286,26 @@ func (p *Pulse) bindKeys() {
 		ui.KeyJ:          ui.NewKeyAction("Down", p.nextFocusCmd(dirDown), false),
 		ui.KeyK:          ui.NewKeyAction("Up", p.nextFocusCmd(dirUp), false),
 		ui.KeyL:          ui.NewKeyAction("Next", p.nextFocusCmd(dirLeft), false),
+		tcell.KeyCtrlS:   ui.NewKeyAction("Save", p.saveCmd, true),
 	}))
 }
 
+func (p *Pulse) saveCmd(*tcell.EventKey) *tcell.EventKey {
+	ts := p.model.Series()
+	if len(ts) == 0 {
+		p.app.Flash().Warn("No metrics recorded yet")
+		return nil
+	}
+	path, err := dao.ExportSeries(p.app.Config.K9s.ContextScreenDumpDir(), "pulse-metrics", ts)
+	if err != nil {
+		p.app.Flash().Err(err)
+		return nil
+	}
+	p.app.Flash().Infof("Metrics saved to %s", path)
+
+	return nil
+}
+
 func (p *Pulse) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 	key := evt.Key()
 	if key == tcell.KeyRune {
@@ -84,6 +84,15 @@ func miscViewers(vv MetaViewers) {
 	vv[client.AliGVR] = MetaViewer{
 		viewerFn: NewAlias,
 	}
+	vv[client.PfpGVR] = MetaViewer{
+		viewerFn: NewPFProfile,
+	}
+	vv[client.VdbGVR] = MetaViewer{
+		viewerFn: NewVulDB,
+	}
+	vv[client.AlGVR] = MetaViewer{
+		viewerFn: NewAlert,
+	}
 	vv[client.RefGVR] = MetaViewer{
 		viewerFn: NewReference,
 	}
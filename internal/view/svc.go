@@ -179,6 +179,7 @@ func (s *Service) runBenchmark(port string, cfg *config.BenchConfig) error {
 	name := s.App().Config.K9s.ActiveContextName()
 
 	go s.bench.Run(ct.ClusterName, name, s.benchDone)
+	go trackBenchProgress(s.App(), s.bench)
 
 	return nil
 }
@@ -135,6 +135,27 @@ func init() {
 		Verbs:        []string{"get", "list", "watch", "delete"},
 		Categories:   []string{"k9s"},
 	})
+	dao.MetaAccess.RegisterMeta(client.PfpGVR.String(), &metav1.APIResource{
+		Name:         "pf-profiles",
+		SingularName: "pf-profile",
+		Kind:         "PFProfiles",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	})
+	dao.MetaAccess.RegisterMeta(client.AlGVR.String(), &metav1.APIResource{
+		Name:         "alerts",
+		SingularName: "alert",
+		Kind:         "Alerts",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	})
+	dao.MetaAccess.RegisterMeta(client.VdbGVR.String(), &metav1.APIResource{
+		Name:         "vuldbs",
+		SingularName: "vuldb",
+		Kind:         "VulDB",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	})
 	dao.MetaAccess.RegisterMeta(client.StsGVR.String(), &metav1.APIResource{
 		Name:         "statefulsets",
 		SingularName: "statefulset",
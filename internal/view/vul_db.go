@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/vul"
+	"github.com/derailed/tcell/v2"
+)
+
+// VulDB presents a vulnerability database status viewer.
+type VulDB struct {
+	ResourceViewer
+}
+
+// NewVulDB returns a new viewer.
+func NewVulDB(gvr *client.GVR) ResourceViewer {
+	v := VulDB{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	v.AddBindKeysFn(v.bindKeys)
+
+	return &v
+}
+
+func (v *VulDB) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlW, tcell.KeyCtrlZ, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyR, ui.NewKeyAction("Reload DB", v.reloadCmd, true))
+}
+
+func (v *VulDB) reloadCmd(*tcell.EventKey) *tcell.EventKey {
+	if vul.ImgScanner == nil {
+		v.App().Flash().Warn("Image scans are disabled")
+		return nil
+	}
+	if vul.ImgScanner.DBStatus().State == vul.DBLoading {
+		v.App().Flash().Warn("Vulnerability database is loading")
+		return nil
+	}
+
+	v.App().Flash().Info("Reloading vulnerability database...")
+	go func() {
+		vul.ImgScanner.Reload()
+		st := vul.ImgScanner.DBStatus()
+		v.App().QueueUpdateDraw(func() {
+			if st.IsHealthy() {
+				v.App().Flash().Infof("Vulnerability database %s", st.State)
+			} else {
+				v.App().Flash().Warnf("Vulnerability database %s", st.State)
+			}
+			v.Refresh()
+		})
+	}()
+
+	return nil
+}
@@ -5,16 +5,23 @@ package view
 
 import (
 	"context"
+	"sync/atomic"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/vul"
 	"github.com/derailed/tcell/v2"
 )
 
+const vulnCol = "VULN"
+
 // VulnerabilityExtender adds vul image scan extensions.
 type VulnerabilityExtender struct {
 	ResourceViewer
+
+	breaches atomic.Int32
 }
 
 // NewVulnerabilityExtender returns a new extender.
@@ -34,9 +41,59 @@ func (v *VulnerabilityExtender) bindKeys(aa *ui.KeyActions) {
 	}
 }
 
+// Start starts the view updater.
+func (v *VulnerabilityExtender) Start() {
+	v.ResourceViewer.Start()
+	if v.App().Config.K9s.ImageScans.Enable {
+		v.GetTable().GetModel().AddListener(v)
+	}
+}
+
+// Stop terminates the view updater.
+func (v *VulnerabilityExtender) Stop() {
+	v.GetTable().GetModel().RemoveListener(v)
+	v.ResourceViewer.Stop()
+}
+
+// TableNoData notifies view no data is available.
+func (*VulnerabilityExtender) TableNoData(*model1.TableData) {}
+
+// TableLoadFailed notifies view something went south.
+func (*VulnerabilityExtender) TableLoadFailed(error) {}
+
+// TableDataChanged warns about workloads breaching the scan policy.
+func (v *VulnerabilityExtender) TableDataChanged(data *model1.TableData) {
+	n := countBreaches(data)
+	if old := v.breaches.Swap(int32(n)); n == 0 || int32(n) == old {
+		return
+	}
+	v.App().QueueUpdateDraw(func() {
+		v.App().Flash().Warnf("%d %s breach the vulnerability scan policy", n, v.GVR().R())
+	})
+}
+
+func countBreaches(data *model1.TableData) int {
+	idx, ok := data.Header().IndexOf(vulnCol, true)
+	if !ok {
+		return 0
+	}
+	var n int
+	data.RowsRange(func(_ int, re model1.RowEvent) bool {
+		if idx < len(re.Row.Fields) && vul.IsBreach(re.Row.Fields[idx]) {
+			n++
+		}
+		return true
+	})
+
+	return n
+}
+
 func (v *VulnerabilityExtender) showVulCmd(*tcell.EventKey) *tcell.EventKey {
 	isv := NewImageScan(client.ScnGVR)
 	isv.SetContextFn(v.selContext)
+	if s, ok := isv.(*ImageScan); ok {
+		s.SetSubject(v.GVR(), v.GetTable().GetSelectedItem())
+	}
 	if err := v.App().inject(isv, false); err != nil {
 		v.App().Flash().Err(err)
 	}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountBreaches(t *testing.T) {
+	uu := map[string]struct {
+		h  model1.Header
+		rr [][]string
+		e  int
+	}{
+		"no-col": {
+			h:  model1.Header{{Name: "NAME"}},
+			rr: [][]string{{"p1"}},
+		},
+		"breaches": {
+			h: model1.Header{{Name: "NAME"}, {Name: "VULN"}},
+			rr: [][]string{
+				{"p1", "OK"},
+				{"p2", "BREACH 1C/0H"},
+				{"p3", "n/a"},
+				{"p4", "BREACH 0C/4H"},
+			},
+			e: 2,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			re := model1.NewRowEvents(len(u.rr))
+			for _, ff := range u.rr {
+				re.Add(model1.NewRowEvent(model1.EventAdd, model1.Row{ID: ff[0], Fields: ff}))
+			}
+			data := model1.NewTableDataWithRows(client.PodGVR, u.h, re)
+			assert.Equal(t, u.e, countBreaches(data))
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 
@@ -142,6 +144,7 @@ func (x *Xray) bindKeys() {
 		ui.KeySlash:     ui.NewSharedKeyAction("Filter Mode", x.activateCmd, false),
 		tcell.KeyEscape: ui.NewSharedKeyAction("Filter Reset", x.resetCmd, false),
 		tcell.KeyEnter:  ui.NewKeyAction("Goto", x.gotoCmd, true),
+		ui.KeyShiftE:    ui.NewKeyAction("Export", x.exportCmd, true),
 	})
 }
 
@@ -527,6 +530,68 @@ func (x *Xray) gotoCmd(*tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (x *Xray) exportCmd(*tcell.EventKey) *tcell.EventKey {
+	root := x.model.Peek()
+	if root == nil {
+		x.app.Flash().Warn("Nothing to export")
+		return nil
+	}
+	root = x.filter(root)
+	if root == nil {
+		x.app.Flash().Warn("Nothing to export")
+		return nil
+	}
+
+	formats := make([]string, 0, len(xray.ExportFormats))
+	for _, f := range xray.ExportFormats {
+		formats = append(formats, string(f))
+	}
+	d := x.app.Styles.Dialog()
+	dialog.ShowSelection(&d, x.app.Content.Pages, "Export Format", formats, func(i int) {
+		if i < 0 {
+			return
+		}
+		ns := x.model.GetNamespace()
+		if client.IsClusterWide(ns) {
+			ns = client.NamespaceAll
+		}
+		fPath, err := saveXray(x.app.Config.K9s.ContextScreenDumpDir(), ns, xrayTitle+"-"+x.gvr.R(), root, xray.ExportFormat(formats[i]))
+		if err != nil {
+			x.app.Flash().Err(err)
+			return
+		}
+		x.app.Flash().Infof("Xray exported to %s", fPath)
+	})
+
+	return nil
+}
+
+func saveXray(dir, ns, title string, root *xray.TreeNode, f xray.ExportFormat) (string, error) {
+	fPath, err := computeFilename(dir, ns, title, "")
+	if err != nil {
+		return "", err
+	}
+	// Only the file name is lowercased so exports land in the dump dir as is.
+	fName := filepath.Base(fPath)
+	fPath = filepath.Join(dir, strings.TrimSuffix(fName, filepath.Ext(fName))+f.Ext())
+	slog.Debug("Saving xray to disk", slogs.FileName, fPath)
+
+	out, err := os.OpenFile(fPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := out.Close(); err != nil {
+			slog.Error("Closing file failed",
+				slogs.Path, fPath,
+				slogs.Error, err,
+			)
+		}
+	}()
+
+	return fPath, root.Export(out, f)
+}
+
 func (x *Xray) filter(root *xray.TreeNode) *xray.TreeNode {
 	q := x.CmdBuff().GetText()
 	if x.CmdBuff().Empty() || internal.IsLabelSelector(q) {
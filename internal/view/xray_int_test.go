@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveXray(t *testing.T) {
+	root := xray.NewTreeNode(client.DpGVR, "deployments")
+	root.Add(xray.NewTreeNode(client.DpGVR, "default/fred"))
+
+	uu := map[string]struct {
+		f   xray.ExportFormat
+		ext string
+	}{
+		"dot":     {f: xray.DOTFormat, ext: ".dot"},
+		"mermaid": {f: xray.MermaidFormat, ext: ".mmd"},
+		"json":    {f: xray.JSONFormat, ext: ".json"},
+	}
+
+	dir := t.TempDir()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			fPath, err := saveXray(dir, "default", "Xray-deployments", root, u.f)
+			require.NoError(t, err)
+			assert.Equal(t, dir, filepath.Dir(fPath))
+			assert.True(t, strings.HasPrefix(filepath.Base(fPath), "xray-deployments-default-"))
+			assert.Equal(t, u.ext, filepath.Ext(fPath))
+
+			bb, err := os.ReadFile(fPath)
+			require.NoError(t, err)
+			assert.Contains(t, string(bb), "fred")
+		})
+	}
+}
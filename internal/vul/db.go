@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package vul
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/anchore/grype/cmd/grype/cli/options"
+	v6 "github.com/anchore/grype/grype/db/v6"
+	v6dist "github.com/anchore/grype/grype/db/v6/distribution"
+	v6inst "github.com/anchore/grype/grype/db/v6/installation"
+	"github.com/anchore/grype/grype/vulnerability"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/slogs"
+)
+
+// DBState represents the vulnerability database state.
+type DBState string
+
+const (
+	// DBDisabled indicates image scans are disabled.
+	DBDisabled DBState = "DISABLED"
+
+	// DBLoading indicates the database is being loaded.
+	DBLoading DBState = "LOADING"
+
+	// DBReady indicates the database is loaded and current.
+	DBReady DBState = "READY"
+
+	// DBStale indicates the database is older than its max age.
+	DBStale DBState = "STALE"
+
+	// DBMissing indicates no database is installed.
+	DBMissing DBState = "MISSING"
+
+	// DBFailed indicates the database could not be loaded.
+	DBFailed DBState = "FAILED"
+)
+
+// DBStatus tracks the vulnerability database status.
+type DBStatus struct {
+	State         DBState
+	SchemaVersion string
+	Built         time.Time
+	From          string
+	Path          string
+	Archive       string
+	UpdateURL     string
+	AutoUpdate    bool
+	MaxAge        time.Duration
+	Checked       time.Time
+	Updated       bool
+	UpdateErr     error
+	Err           error
+}
+
+// IsHealthy checks if the database is usable for scans.
+func (s DBStatus) IsHealthy() bool {
+	return s.State == DBReady
+}
+
+// Age returns the database age at a given time.
+func (s DBStatus) Age(now time.Time) time.Duration {
+	if s.Built.IsZero() {
+		return 0
+	}
+
+	return now.Sub(s.Built)
+}
+
+// applyDBConfig applies database options to grype's.
+func applyDBConfig(opts *options.Grype, cfg config.ScanDB) {
+	if cfg.UpdateURL != "" {
+		opts.DB.UpdateURL = cfg.UpdateURL
+	}
+	opts.DB.AutoUpdate = !cfg.NoUpdate
+	if age, ok := cfg.MaxAgeDuration(); ok {
+		opts.DB.ValidateAge = true
+		opts.DB.MaxAllowedBuiltAge = age
+	}
+}
+
+// newDBStatus returns the database status prior to loading.
+func newDBStatus(opts *options.Grype, cfg config.ScanDB) DBStatus {
+	st := DBStatus{
+		State:      DBLoading,
+		Archive:    cfg.Archive,
+		UpdateURL:  opts.DB.UpdateURL,
+		AutoUpdate: opts.DB.AutoUpdate,
+		Path:       opts.ToCuratorConfig().DBFilePath(),
+	}
+	if opts.DB.ValidateAge {
+		st.MaxAge = opts.DB.MaxAllowedBuiltAge
+	}
+
+	return st
+}
+
+// loadDB imports, updates and loads the vulnerability database, recording
+// each step outcome in the database status.
+func (s *imageScanner) loadDB(opts *options.Grype, st *DBStatus) (vulnerability.Provider, *vulnerability.ProviderStatus, error) {
+	client, err := v6dist.NewClient(opts.ToClientConfig())
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create distribution client: %w", err)
+	}
+	instCfg := opts.ToCuratorConfig()
+	c, err := v6inst.NewCurator(instCfg, client)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create curator: %w", err)
+	}
+
+	if st.Archive != "" {
+		ok, err := isArchiveNewer(st.Archive, instCfg.DBFilePath())
+		switch {
+		case err != nil:
+			st.UpdateErr = err
+		case ok:
+			s.log.Info("Importing vulnerability db archive", slogs.Path, st.Archive)
+			if err := c.Import(st.Archive); err != nil {
+				st.UpdateErr = fmt.Errorf("archive import failed: %w", err)
+			} else {
+				st.Updated = true
+			}
+		}
+	}
+
+	if opts.DB.AutoUpdate {
+		st.Checked = time.Now()
+		updated, err := c.Update()
+		if err != nil {
+			s.log.Warn("VulDb update failed", slogs.Error, err)
+			st.UpdateErr = errors.Join(st.UpdateErr, fmt.Errorf("update failed: %w", err))
+		}
+		st.Updated = st.Updated || updated
+	}
+
+	status := c.Status()
+	if status.Error != nil {
+		return nil, &status, status.Error
+	}
+	rdr, err := c.Reader()
+	if err != nil {
+		return nil, &status, fmt.Errorf("unable to create db reader: %w", err)
+	}
+
+	return v6.NewVulnerabilityProvider(rdr), &status, nil
+}
+
+// isArchiveNewer checks if a database archive was modified after the
+// installed database.
+func isArchiveNewer(archive, dbFile string) (bool, error) {
+	ai, err := os.Stat(archive)
+	if err != nil {
+		return false, fmt.Errorf("unable to access db archive: %w", err)
+	}
+	di, err := os.Stat(dbFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return ai.ModTime().After(di.ModTime()), nil
+}
+
+// dbState returns the database state given its load outcome.
+func dbState(status *vulnerability.ProviderStatus, loadErr error, maxAge time.Duration, now time.Time) DBState {
+	if loadErr == nil && status != nil && status.Error == nil {
+		return DBReady
+	}
+	if status == nil {
+		return DBFailed
+	}
+	if status.Built.IsZero() {
+		return DBMissing
+	}
+	if maxAge > 0 && now.Sub(status.Built) > maxAge {
+		return DBStale
+	}
+
+	return DBFailed
+}
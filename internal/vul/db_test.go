@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package vul
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/anchore/clio"
+	"github.com/anchore/grype/cmd/grype/cli/options"
+	"github.com/anchore/grype/grype/vulnerability"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyDBConfig(t *testing.T) {
+	uu := map[string]struct {
+		cfg        config.ScanDB
+		url        string
+		autoUpdate bool
+		maxAge     time.Duration
+	}{
+		"defaults": {
+			autoUpdate: true,
+			maxAge:     5 * 24 * time.Hour,
+		},
+		"offline": {
+			cfg: config.ScanDB{
+				Archive:   "/tmp/db.tar.zst",
+				UpdateURL: "https://mirror.example.com/latest.json",
+				MaxAge:    "720h",
+				NoUpdate:  true,
+			},
+			url:    "https://mirror.example.com/latest.json",
+			maxAge: 720 * time.Hour,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			opts := options.DefaultGrype(clio.Identification{Name: "k9s"})
+			url := opts.DB.UpdateURL
+			if u.url != "" {
+				url = u.url
+			}
+			applyDBConfig(opts, u.cfg)
+			st := newDBStatus(opts, u.cfg)
+
+			assert.Equal(t, DBLoading, st.State)
+			assert.Equal(t, url, st.UpdateURL)
+			assert.Equal(t, u.autoUpdate, st.AutoUpdate)
+			assert.Equal(t, u.maxAge, st.MaxAge)
+			assert.Equal(t, u.cfg.Archive, st.Archive)
+		})
+	}
+}
+
+func TestIsArchiveNewer(t *testing.T) {
+	dir := t.TempDir()
+	archive, dbFile := filepath.Join(dir, "db.tar.zst"), filepath.Join(dir, "vulnerability.db")
+	require.NoError(t, os.WriteFile(archive, []byte("fred"), 0o600))
+
+	ok, err := isArchiveNewer(archive, dbFile)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	require.NoError(t, os.WriteFile(dbFile, []byte("blee"), 0o600))
+	past := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(archive, past, past))
+	ok, err = isArchiveNewer(archive, dbFile)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, err = isArchiveNewer(filepath.Join(dir, "zorg.tar.zst"), dbFile)
+	require.Error(t, err)
+}
+
+func TestDBState(t *testing.T) {
+	now := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+	uu := map[string]struct {
+		status *vulnerability.ProviderStatus
+		err    error
+		maxAge time.Duration
+		e      DBState
+	}{
+		"ready": {
+			status: &vulnerability.ProviderStatus{Built: now.Add(-time.Hour)},
+			e:      DBReady,
+		},
+		"no-curator": {
+			err: errors.New("boom"),
+			e:   DBFailed,
+		},
+		"missing": {
+			status: &vulnerability.ProviderStatus{Error: errors.New("database not found")},
+			err:    errors.New("database not found"),
+			e:      DBMissing,
+		},
+		"stale": {
+			status: &vulnerability.ProviderStatus{Built: now.Add(-72 * time.Hour), Error: errors.New("too old")},
+			err:    errors.New("too old"),
+			maxAge: 48 * time.Hour,
+			e:      DBStale,
+		},
+		"checksum": {
+			status: &vulnerability.ProviderStatus{Built: now.Add(-time.Hour), Error: errors.New("bad checksum")},
+			err:    errors.New("bad checksum"),
+			maxAge: 48 * time.Hour,
+			e:      DBFailed,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dbState(u.status, u.err, u.maxAge, now))
+		})
+	}
+}
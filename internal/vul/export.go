@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package vul
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/anchore/grype/grype/presenter/json"
+	"github.com/anchore/grype/grype/presenter/models"
+	"github.com/anchore/grype/grype/presenter/sarif"
+	"github.com/anchore/syft/syft/format/cyclonedxjson"
+	"github.com/anchore/syft/syft/format/spdxjson"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/derailed/k9s/internal/config/data"
+)
+
+// ExportFormat represents a scan export format.
+type ExportFormat string
+
+const (
+	// CycloneDXFormat exports a CycloneDX json SBOM.
+	CycloneDXFormat ExportFormat = "cyclonedx"
+
+	// SPDXFormat exports an SPDX json SBOM.
+	SPDXFormat ExportFormat = "spdx"
+
+	// JSONFormat exports a grype json vulnerability report.
+	JSONFormat ExportFormat = "json"
+
+	// SARIFFormat exports a SARIF vulnerability report.
+	SARIFFormat ExportFormat = "sarif"
+)
+
+// ExportFormats tracks all supported export formats.
+var ExportFormats = []ExportFormat{CycloneDXFormat, SPDXFormat, JSONFormat, SARIFFormat}
+
+// ParseExportFormats parses a comma separated list of export formats.
+func ParseExportFormats(s string) ([]ExportFormat, error) {
+	ff := make([]ExportFormat, 0, len(ExportFormats))
+	for _, f := range strings.Split(s, ",") {
+		f := ExportFormat(strings.ToLower(strings.TrimSpace(f)))
+		if !slices.Contains(ExportFormats, f) {
+			return nil, fmt.Errorf("unsupported export format %q", f)
+		}
+		if !slices.Contains(ff, f) {
+			ff = append(ff, f)
+		}
+	}
+
+	return ff, nil
+}
+
+// Ext returns the export file extension.
+func (f ExportFormat) Ext() string {
+	switch f {
+	case CycloneDXFormat:
+		return ".cdx.json"
+	case SPDXFormat:
+		return ".spdx.json"
+	case SARIFFormat:
+		return ".sarif"
+	default:
+		return ".vuln.json"
+	}
+}
+
+// writeReport writes out an image report in the given format.
+func writeReport(w io.Writer, r *models.PresenterConfig, f ExportFormat) error {
+	switch f {
+	case CycloneDXFormat:
+		return encodeSBOM(w, r.SBOM, cyclonedxjson.NewFormatEncoderWithConfig, cyclonedxjson.EncoderConfig{
+			Version: cyclonedxjson.DefaultEncoderConfig().Version,
+			Pretty:  true,
+		})
+	case SPDXFormat:
+		return encodeSBOM(w, r.SBOM, spdxjson.NewFormatEncoderWithConfig, spdxjson.EncoderConfig{
+			Version: spdxjson.DefaultEncoderConfig().Version,
+			Pretty:  true,
+		})
+	case JSONFormat:
+		return json.NewPresenter(*r).Present(w)
+	case SARIFFormat:
+		return sarif.NewPresenter(*r).Present(w)
+	default:
+		return fmt.Errorf("unsupported export format %q", f)
+	}
+}
+
+func encodeSBOM[C any](w io.Writer, sb *sbom.SBOM, newEncoder func(C) (sbom.FormatEncoder, error), cfg C) error {
+	enc, err := newEncoder(cfg)
+	if err != nil {
+		return err
+	}
+
+	return enc.Encode(w, *sb)
+}
+
+// reportFn builds a given image report.
+type reportFn func(img string) (*models.PresenterConfig, error)
+
+// exportReports writes out each image report in the given formats to a
+// directory and returns the exported file paths. At most scanConcurrency
+// reports are held at once.
+func exportReports(ctx context.Context, dir string, images []string, fn reportFn, ff ...ExportFormat) ([]string, error) {
+	if err := data.EnsureFullPath(dir, data.DefaultDirMod); err != nil {
+		return nil, err
+	}
+
+	images = slices.Sorted(slices.Values(images))
+	images = slices.Compact(images)
+	var (
+		files = make([][]string, len(images))
+		errs  = make([]error, len(images))
+		sem   = make(chan struct{}, scanConcurrency)
+		wg    sync.WaitGroup
+	)
+	for i, img := range images {
+		select {
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		case sem <- struct{}{}:
+		}
+		wg.Add(1)
+		go func() {
+			defer func() { <-sem; wg.Done() }()
+			files[i], errs[i] = exportImage(dir, img, fn, ff)
+		}()
+	}
+	wg.Wait()
+
+	return slices.Concat(files...), errors.Join(errs...)
+}
+
+func exportImage(dir, img string, fn reportFn, ff []ExportFormat) ([]string, error) {
+	r, err := fn(img)
+	if err != nil {
+		return nil, fmt.Errorf("scan failed for image %s: %w", img, err)
+	}
+	if r == nil || r.SBOM == nil {
+		return nil, fmt.Errorf("no scan report available for image %s", img)
+	}
+
+	var errs error
+	files := make([]string, 0, len(ff))
+	for _, f := range ff {
+		path := filepath.Join(dir, data.SanitizeFileName(img)+f.Ext())
+		if err := exportFile(path, r, f); err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		files = append(files, path)
+	}
+
+	return files, errs
+}
+
+func exportFile(path string, r *models.PresenterConfig, f ExportFormat) error {
+	out, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, data.DefaultFileMod)
+	if err != nil {
+		return err
+	}
+	if err := writeReport(out, r, f); err != nil {
+		_ = out.Close()
+		_ = os.Remove(path)
+		return err
+	}
+
+	return out.Close()
+}
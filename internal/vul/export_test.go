@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package vul
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/anchore/clio"
+	"github.com/anchore/grype/grype/presenter/models"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExportFormats(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		e   []ExportFormat
+		err string
+	}{
+		"single": {
+			s: "sarif",
+			e: []ExportFormat{SARIFFormat},
+		},
+		"multi": {
+			s: "CycloneDX, spdx,json,spdx",
+			e: []ExportFormat{CycloneDXFormat, SPDXFormat, JSONFormat},
+		},
+		"toast": {
+			s:   "json,xml",
+			err: `unsupported export format "xml"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ff, err := ParseExportFormats(u.s)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.e, ff)
+		})
+	}
+}
+
+func TestWriteReport(t *testing.T) {
+	r := makeTestReport("nginx:1.25")
+
+	uu := map[string]struct {
+		f   ExportFormat
+		key string
+	}{
+		"cyclonedx": {f: CycloneDXFormat, key: "bomFormat"},
+		"spdx":      {f: SPDXFormat, key: "spdxVersion"},
+		"json":      {f: JSONFormat, key: "matches"},
+		"sarif":     {f: SARIFFormat, key: "runs"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var buff bytes.Buffer
+			require.NoError(t, writeReport(&buff, r, u.f))
+
+			var m map[string]any
+			require.NoError(t, json.Unmarshal(buff.Bytes(), &m))
+			assert.Contains(t, m, u.key)
+		})
+	}
+}
+
+func TestExportReports(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "scans")
+	fn := func(img string) (*models.PresenterConfig, error) {
+		switch img {
+		case "toast":
+			return nil, errors.New("boom")
+		case "blank":
+			return nil, nil
+		default:
+			return makeTestReport(img), nil
+		}
+	}
+
+	images := []string{"nginx:1.25", "docker.io/redis:7.2", "toast", "blank", "nginx:1.25"}
+	ff, err := exportReports(context.Background(), dir, images, fn, SPDXFormat, SARIFFormat)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "scan failed for image toast: boom")
+	assert.ErrorContains(t, err, "no scan report available for image blank")
+	assert.Equal(t, []string{
+		filepath.Join(dir, "docker.io-redis-7.2.spdx.json"),
+		filepath.Join(dir, "docker.io-redis-7.2.sarif"),
+		filepath.Join(dir, "nginx-1.25.spdx.json"),
+		filepath.Join(dir, "nginx-1.25.sarif"),
+	}, ff)
+	ee, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, ee, 4)
+}
+
+func TestScanWait(t *testing.T) {
+	sc := newScan("fred")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, sc.Wait(ctx), context.DeadlineExceeded)
+
+	sc.complete(errors.New("boom"))
+	assert.EqualError(t, sc.Wait(context.Background()), "boom")
+}
+
+// Helpers...
+
+func makeTestReport(img string) *models.PresenterConfig {
+	p := pkg.Package{Name: "openssl", Version: "3.0.1", Type: pkg.DebPkg}
+	p.SetID()
+	src := source.Description{
+		Name:     img,
+		Metadata: source.ImageMetadata{UserInput: img},
+	}
+	sb := sbom.SBOM{
+		Artifacts: sbom.Artifacts{Packages: pkg.NewCollection(p)},
+		Source:    src,
+	}
+
+	return &models.PresenterConfig{
+		ID:       clio.Identification{Name: "k9s", Version: "test"},
+		Document: models.Document{Matches: []models.Match{}},
+		SBOM:     &sb,
+	}
+}
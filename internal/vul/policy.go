@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package vul
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+)
+
+// VerdictState represents a scan policy evaluation outcome.
+type VerdictState int
+
+const (
+	// VerdictNA indicates no policy applies or scans are pending.
+	VerdictNA VerdictState = iota
+
+	// VerdictOK indicates the vulnerability budgets are met.
+	VerdictOK
+
+	// VerdictBreach indicates the vulnerability budgets are exceeded.
+	VerdictBreach
+)
+
+const (
+	verdictOK     = "OK"
+	verdictBreach = "BREACH"
+)
+
+// Verdict tracks a workload scan policy evaluation.
+type Verdict struct {
+	State          VerdictState
+	Critical, High int
+}
+
+// String returns the verdict status.
+func (v Verdict) String() string {
+	switch v.State {
+	case VerdictOK:
+		return verdictOK
+	case VerdictBreach:
+		return verdictBreach + " " + strconv.Itoa(v.Critical) + "C/" + strconv.Itoa(v.High) + "H"
+	default:
+		return naValue
+	}
+}
+
+// IsBreach checks if the verdict is a policy violation.
+func (v Verdict) IsBreach() bool {
+	return v.State == VerdictBreach
+}
+
+// IsBreach checks if a rendered verdict is a policy violation.
+func IsBreach(s string) bool {
+	return len(s) >= len(verdictBreach) && s[:len(verdictBreach)] == verdictBreach
+}
+
+// Verdict evaluates the scans for a workload images against the scan policy.
+// Verdicts are n/a until all scans completed successfully.
+func (s *imageScanner) Verdict(ns string, lbls map[string]string, images ...string) Verdict {
+	rule, ok := s.config.Policy.RuleFor(ns, lbls)
+	if !ok {
+		return Verdict{}
+	}
+
+	var (
+		t   tally
+		now = time.Now()
+	)
+	for _, img := range images {
+		sc, ok := s.GetScan(img)
+		if !ok || !sc.isDone() || sc.failed() {
+			return Verdict{}
+		}
+		tt := sc.policyTally(s.config.Policy, rule.FixableOnly, now)
+		t[sevCritical] += tt[sevCritical]
+		t[sevHigh] += tt[sevHigh]
+	}
+
+	return evaluate(rule, t)
+}
+
+// policyTally returns the scan tally less accepted and, if requested, unfixable
+// vulnerabilities. Tallies are cached for the day since acceptances expire
+// on day boundaries.
+func (s *Scan) policyTally(p config.ScanPolicy, fixableOnly bool, now time.Time) tally {
+	day := now.Format(time.DateOnly)
+
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	if s.tallyDay != day {
+		s.tallies, s.tallyDay = make(map[bool]tally, 2), day
+	}
+	if t, ok := s.tallies[fixableOnly]; ok {
+		return t
+	}
+	t := newTally(s.Table.filter(policyFilter(p, fixableOnly, now)))
+	s.tallies[fixableOnly] = t
+
+	return t
+}
+
+func evaluate(rule config.ScanRule, t tally) Verdict {
+	v := Verdict{State: VerdictOK, Critical: t[sevCritical], High: t[sevHigh]}
+	if exceeds(rule.MaxCritical, v.Critical) || exceeds(rule.MaxHigh, v.High) {
+		v.State = VerdictBreach
+	}
+
+	return v
+}
+
+func exceeds(budget *int, n int) bool {
+	return budget != nil && n > *budget
+}
+
+// policyFilter returns a row filter skipping accepted and, if requested, unfixable vulnerabilities.
+func policyFilter(p config.ScanPolicy, fixableOnly bool, now time.Time) func(Row) bool {
+	return func(r Row) bool {
+		if fixableOnly && (r.Fix() == naValue || r.Fix() == wontFix) {
+			return false
+		}
+
+		return !p.IsAccepted(r.Vulnerability(), now)
+	}
+}
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package vul
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerdictString(t *testing.T) {
+	uu := map[string]struct {
+		v Verdict
+		e string
+	}{
+		"na":     {e: naValue},
+		"ok":     {v: Verdict{State: VerdictOK, Critical: 1}, e: "OK"},
+		"breach": {v: Verdict{State: VerdictBreach, Critical: 2, High: 5}, e: "BREACH 2C/5H"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.v.String())
+			assert.Equal(t, u.v.IsBreach(), IsBreach(u.v.String()))
+		})
+	}
+}
+
+func TestImageScannerVerdict(t *testing.T) {
+	zero, two := 0, 2
+	cfg := config.NewImageScans()
+	cfg.Policy = config.ScanPolicy{
+		Rules: []config.ScanRule{
+			{Namespaces: []string{"prod"}, MaxCritical: &zero, MaxHigh: &two},
+			{Namespaces: []string{"dev"}, MaxCritical: &zero, FixableOnly: true},
+		},
+		Allowlist: []config.AcceptedCVE{
+			{ID: "CVE-1", Expires: time.Now().AddDate(0, 0, 1).Format(time.DateOnly)},
+			{ID: "CVE-2", Expires: time.Now().AddDate(0, 0, -1).Format(time.DateOnly)},
+		},
+	}
+	s := NewImageScanner(cfg, slog.Default())
+	s.setTestScan("i1", true,
+		newRow("openssl", "1.0", "1.1", "deb", "CVE-1", "Critical"),
+		newRow("zlib", "1.0", "", "deb", "CVE-3", "High"),
+	)
+	s.setTestScan("i2", true,
+		newRow("curl", "1.0", wontFix, "deb", "CVE-2", "Critical"),
+		newRow("libc", "1.0", "2.0", "deb", "CVE-4", "High"),
+		newRow("bash", "1.0", "2.0", "deb", "CVE-5", "High"),
+	)
+	s.setTestScan("i3", false)
+	sc, _ := s.ensureScan("i5")
+	sc.complete(errors.New("boom"))
+
+	uu := map[string]struct {
+		ns     string
+		images []string
+		e      Verdict
+	}{
+		"no-rule": {
+			ns:     "fred",
+			images: []string{"i1"},
+		},
+		"pending": {
+			ns:     "prod",
+			images: []string{"i1", "i3"},
+		},
+		"unknown": {
+			ns:     "prod",
+			images: []string{"i4"},
+		},
+		"failed": {
+			ns:     "prod",
+			images: []string{"i1", "i5"},
+		},
+		"accepted": {
+			ns:     "prod",
+			images: []string{"i1"},
+			e:      Verdict{State: VerdictOK, High: 1},
+		},
+		"breach": {
+			ns:     "prod",
+			images: []string{"i1", "i2"},
+			e:      Verdict{State: VerdictBreach, Critical: 1, High: 3},
+		},
+		"fixable-only": {
+			ns:     "dev",
+			images: []string{"i1", "i2"},
+			e:      Verdict{State: VerdictOK, High: 2},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, s.Verdict(u.ns, nil, u.images...))
+		})
+	}
+}
+
+func TestScanPolicyTally(t *testing.T) {
+	now := time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+	p := config.ScanPolicy{
+		Allowlist: []config.AcceptedCVE{
+			{ID: "CVE-1", Expires: now.Format(time.DateOnly)},
+		},
+	}.Validate()
+	sc := newScan("i1")
+	sc.Table.addRow(newRow("openssl", "1.0", "1.1", "deb", "CVE-1", "Critical"))
+	sc.Table.addRow(newRow("zlib", "1.0", "", "deb", "CVE-2", "Critical"))
+	sc.complete(nil)
+
+	assert.Equal(t, 1, sc.policyTally(p, false, now)[sevCritical])
+	assert.Equal(t, 0, sc.policyTally(p, true, now)[sevCritical])
+	assert.Equal(t, 1, sc.policyTally(p, false, now.Add(time.Hour))[sevCritical])
+	assert.Equal(t, 2, sc.policyTally(p, false, now.AddDate(0, 0, 1))[sevCritical])
+}
+
+// Helpers...
+
+func (s *imageScanner) setTestScan(img string, done bool, rr ...Row) {
+	sc, _ := s.ensureScan(img)
+	for _, r := range rr {
+		sc.Table.addRow(r)
+	}
+	if done {
+		sc.complete(nil)
+	}
+}
@@ -4,9 +4,11 @@
 package vul
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"strings"
+	"sync"
 
 	"github.com/anchore/grype/grype/match"
 	"github.com/anchore/grype/grype/vulnerability"
@@ -39,10 +41,56 @@ type Scan struct {
 	ID    string
 	Table *table
 	Tally tally
+
+	err      error
+	done     chan struct{}
+	tallies  map[bool]tally
+	tallyDay string
+	mx       sync.RWMutex
 }
 
 func newScan(img string) *Scan {
-	return &Scan{ID: img, Table: newTable()}
+	return &Scan{ID: img, Table: newTable(), done: make(chan struct{})}
+}
+
+// Wait waits for the scan to complete and returns the scan error if any.
+func (s *Scan) Wait(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-s.done:
+	}
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	return s.err
+}
+
+func (s *Scan) isDone() bool {
+	select {
+	case <-s.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// failed checks if the scan completed with an error.
+func (s *Scan) failed() bool {
+	if !s.isDone() {
+		return false
+	}
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	return s.err != nil
+}
+
+func (s *Scan) complete(err error) {
+	s.mx.Lock()
+	s.err = err
+	s.mx.Unlock()
+	close(s.done)
 }
 
 // Dump dump report to stdout.
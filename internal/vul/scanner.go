@@ -24,9 +24,11 @@ import (
 	"github.com/anchore/grype/grype/matcher/ruby"
 	"github.com/anchore/grype/grype/matcher/stock"
 	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/presenter/models"
 	"github.com/anchore/grype/grype/vex"
 	"github.com/anchore/grype/grype/vulnerability"
 	"github.com/anchore/syft/syft"
+	"github.com/anchore/syft/syft/sbom"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/slogs"
 )
@@ -40,9 +42,10 @@ const (
 )
 
 type imageScanner struct {
-	provider    vulnerability.Provider
-	status      *vulnerability.ProviderStatus
-	opts        *options.Grype
+	id          clio.Identification
+	vdb         *vulDB
+	db          DBStatus
+	loading     bool
 	scans       Scans
 	mx          sync.RWMutex
 	initialized bool
@@ -50,10 +53,33 @@ type imageScanner struct {
 	log         *slog.Logger
 }
 
+// vulDB tracks a loaded vulnerability database. Scans hold a reference on
+// the database they match against so it is only closed once they drain.
+type vulDB struct {
+	id       clio.Identification
+	opts     *options.Grype
+	provider vulnerability.Provider
+	status   *vulnerability.ProviderStatus
+	refs     sync.WaitGroup
+}
+
+func (d *vulDB) release() {
+	d.refs.Done()
+}
+
+// close closes the database once all scans using it are done.
+func (d *vulDB) close() {
+	d.refs.Wait()
+	if err := d.provider.Close(); err != nil {
+		slog.Warn("VulDb close failed", slogs.Error, err)
+	}
+}
+
 // NewImageScanner returns a new instance.
 func NewImageScanner(cfg config.ImageScans, l *slog.Logger) *imageScanner {
 	return &imageScanner{
 		scans:  make(Scans),
+		db:     DBStatus{State: DBLoading},
 		config: cfg,
 		log:    l.With(slogs.Subsys, "vul"),
 	}
@@ -73,56 +99,124 @@ func (s *imageScanner) GetScan(img string) (*Scan, bool) {
 	return scan, ok
 }
 
-func (s *imageScanner) setScan(img string, sc *Scan) {
+// ensureScan returns the scan for a given image, registering a new one if
+// none exists. Returns ok=true when the scan was just registered.
+func (s *imageScanner) ensureScan(img string) (*Scan, bool) {
 	s.mx.Lock()
 	defer s.mx.Unlock()
 
+	if sc, ok := s.scans[img]; ok {
+		return sc, false
+	}
+	sc := newScan(img)
 	s.scans[img] = sc
+
+	return sc, true
 }
 
-// Init initializes image vulnerability database.
+// Init initializes image vulnerability database. No-op while the database is loading.
 func (s *imageScanner) Init(name, version string) {
+	id := clio.Identification{Name: name, Version: version}
+	s.mx.Lock()
+	if s.loading {
+		s.mx.Unlock()
+		return
+	}
+	s.id, s.loading = id, true
+	s.mx.Unlock()
+
+	s.load(id)
+}
+
+// Reload reloads the vulnerability database, importing or updating it as
+// configured. No-op while the database is loading.
+func (s *imageScanner) Reload() {
+	s.mx.Lock()
+	if s.loading {
+		s.mx.Unlock()
+		return
+	}
+	id := s.id
+	s.loading = true
+	s.mx.Unlock()
+
+	s.load(id)
+}
+
+// load loads the vulnerability database and swaps it in. The previous
+// database is closed once the scans using it complete.
+func (s *imageScanner) load(id clio.Identification) {
 	defer func(t time.Time) {
 		slog.Debug("VulDb initialization complete",
 			slogs.Elapsed, time.Since(t),
 		)
 	}(time.Now())
 
-	opts := options.DefaultGrype(clio.Identification{Name: name, Version: version})
+	opts := options.DefaultGrype(id)
 	opts.GenerateMissingCPEs = true
+	applyDBConfig(opts, s.config.DB)
 
-	provider, status, err := grype.LoadVulnerabilityDB(
-		opts.ToClientConfig(),
-		opts.ToCuratorConfig(),
-		opts.DB.AutoUpdate,
-	)
-	if err != nil {
-		s.log.Error("VulDb load failed", slogs.Error, err)
-		return
-	}
+	st := newDBStatus(opts, s.config.DB)
 	s.mx.Lock()
-	s.opts, s.provider, s.status = opts, provider, status
+	s.db = st
 	s.mx.Unlock()
 
+	provider, status, err := s.loadDB(opts, &st)
+	st.State = dbState(status, err, st.MaxAge, time.Now())
+	if status != nil {
+		st.SchemaVersion, st.Built, st.From = status.SchemaVersion, status.Built, status.From
+	}
 	if e := validateDBLoad(err, status); e != nil {
-		s.log.Error("VulDb validate failed", slogs.Error, e)
+		st.Err = e
+		s.mx.Lock()
+		s.db, s.loading = st, false
+		s.mx.Unlock()
+		s.log.Error("VulDb load failed", slogs.Error, e)
 		return
 	}
 
 	s.mx.Lock()
-	s.initialized = true
+	old := s.vdb
+	s.vdb = &vulDB{id: id, opts: opts, provider: provider, status: status}
+	s.db, s.loading, s.initialized = st, false, true
 	s.mx.Unlock()
+	if old != nil {
+		go old.close()
+	}
 	slog.Debug("VulDB initialized")
 }
 
-// Stop closes scan database.
-func (s *imageScanner) Stop() {
+// acquire returns the current vulnerability database. Callers must release
+// it once done.
+func (s *imageScanner) acquire() (*vulDB, error) {
 	s.mx.RLock()
 	defer s.mx.RUnlock()
 
-	if s.provider != nil {
-		_ = s.provider.Close()
-		s.provider = nil
+	if s.vdb == nil {
+		return nil, errors.New("vulnerability database is not initialized")
+	}
+	s.vdb.refs.Add(1)
+
+	return s.vdb, nil
+}
+
+// DBStatus returns the vulnerability database status.
+func (s *imageScanner) DBStatus() DBStatus {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	return s.db
+}
+
+// Stop closes scan database once pending scans complete.
+func (s *imageScanner) Stop() {
+	s.mx.Lock()
+	vdb := s.vdb
+	s.vdb = nil
+	s.mx.Unlock()
+
+	if vdb != nil {
+		go vdb.close()
 	}
 }
 
@@ -149,25 +243,35 @@ func (s *imageScanner) Enqueue(ctx context.Context, images ...string) {
 	defer cancel()
 
 	for _, img := range images {
-		if _, ok := s.GetScan(img); ok {
-			continue
+		if sc, ok := s.ensureScan(img); ok {
+			go s.scanWorker(ctx, sc)
 		}
-		go s.scanWorker(ctx, img)
 	}
 }
 
-func (s *imageScanner) scanWorker(ctx context.Context, img string) {
+// Export scans the given images and writes out their reports in the given
+// formats to a directory. Reports are built on demand and released once
+// written out. Returns the exported file paths.
+func (s *imageScanner) Export(ctx context.Context, dir string, images []string, ff ...ExportFormat) ([]string, error) {
+	if !s.IsInitialized() {
+		return nil, errors.New("vulnerability database is not initialized")
+	}
+
+	return exportReports(ctx, dir, images, s.report, ff...)
+}
+
+func (s *imageScanner) scanWorker(ctx context.Context, sc *Scan) {
 	defer s.log.Debug("ScanWorker bailing out!")
 
-	s.log.Debug("ScanWorker processing image", slogs.Image, img)
-	sc := newScan(img)
-	s.setScan(img, sc)
-	if err := s.scan(ctx, img, sc); err != nil {
+	s.log.Debug("ScanWorker processing image", slogs.Image, sc.ID)
+	err := s.scan(ctx, sc.ID, sc)
+	if err != nil {
 		s.log.Warn("Scan failed for image",
-			slogs.Image, img,
+			slogs.Image, sc.ID,
 			slogs.Error, err,
 		)
 	}
+	sc.complete(err)
 }
 
 func (s *imageScanner) scan(_ context.Context, img string, sc *Scan) error {
@@ -178,38 +282,78 @@ func (s *imageScanner) scan(_ context.Context, img string, sc *Scan) error {
 		)
 	}(time.Now())
 
-	packages, pkgContext, _, err := pkg.Provide(img, getProviderConfig(s.opts))
+	vdb, err := s.acquire()
 	if err != nil {
-		return fmt.Errorf("failed to analyze image packages: %w", err)
+		return err
+	}
+	defer vdb.release()
+
+	an, err := vdb.analyze(img)
+	if an == nil || an.matches == nil {
+		return err
+	}
+	if e := sc.run(an.matches, vdb.provider); e != nil {
+		err = errors.Join(err, e)
+	}
+
+	return err
+}
+
+// report scans a given image and returns its full report including its SBOM.
+func (s *imageScanner) report(img string) (*models.PresenterConfig, error) {
+	vdb, err := s.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer vdb.release()
+
+	an, err := vdb.analyze(img)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := models.NewDocument(vdb.id, an.packages, an.context, *an.matches, nil, vdb.provider, nil, vdb.status, models.DefaultSortStrategy, true, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.PresenterConfig{ID: vdb.id, Document: doc, SBOM: an.sbom, Pretty: true}, nil
+}
+
+// analysis tracks an image packages and vulnerability matches.
+type analysis struct {
+	packages []pkg.Package
+	context  pkg.Context
+	sbom     *sbom.SBOM
+	matches  *match.Matches
+}
+
+// analyze catalogs a given image packages and matches them against the
+// vulnerability database.
+func (d *vulDB) analyze(img string) (*analysis, error) {
+	packages, pkgContext, sb, err := pkg.Provide(img, getProviderConfig(d.opts))
+	if err != nil {
+		return nil, fmt.Errorf("failed to analyze image packages: %w", err)
 	}
 
 	processor, err := vex.NewProcessor(vex.ProcessorOptions{
-		Documents:   s.opts.VexDocuments,
-		IgnoreRules: s.opts.Ignore,
+		Documents:   d.opts.VexDocuments,
+		IgnoreRules: d.opts.Ignore,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to create VEX processor: %w", err)
+		return nil, fmt.Errorf("failed to create VEX processor: %w", err)
 	}
 
 	v := grype.VulnerabilityMatcher{
-		VulnerabilityProvider: s.provider,
-		IgnoreRules:           s.opts.Ignore,
-		NormalizeByCVE:        s.opts.ByCVE,
-		FailSeverity:          s.opts.FailOnSeverity(),
-		Matchers:              getMatchers(s.opts),
+		VulnerabilityProvider: d.provider,
+		IgnoreRules:           d.opts.Ignore,
+		NormalizeByCVE:        d.opts.ByCVE,
+		FailSeverity:          d.opts.FailOnSeverity(),
+		Matchers:              getMatchers(d.opts),
 		VexProcessor:          processor,
 	}
-
-	var errs error
 	mm, _, err := v.FindMatches(packages, pkgContext)
-	if err != nil {
-		errs = errors.Join(errs, err)
-	}
-	if err := sc.run(mm, s.provider); err != nil {
-		errs = errors.Join(errs, err)
-	}
 
-	return errs
+	return &analysis{packages: packages, context: pkgContext, sbom: sb, matches: mm}, err
 }
 
 func getProviderConfig(opts *options.Grype) pkg.ProviderConfig {
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package vul
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/anchore/grype/grype/vulnerability"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageScannerStopDrains(t *testing.T) {
+	p := newTestProvider()
+	s := NewImageScanner(config.NewImageScans(), slog.Default())
+	s.vdb = &vulDB{provider: p}
+
+	vdb, err := s.acquire()
+	require.NoError(t, err)
+	s.Stop()
+	_, err = s.acquire()
+	require.Error(t, err)
+
+	select {
+	case <-p.closed:
+		require.Fail(t, "database closed while in use")
+	case <-time.After(50 * time.Millisecond):
+	}
+	vdb.release()
+	select {
+	case <-p.closed:
+	case <-time.After(time.Second):
+		require.Fail(t, "database was not closed")
+	}
+}
+
+func TestImageScannerReloadWhileLoading(t *testing.T) {
+	s := NewImageScanner(config.NewImageScans(), slog.Default())
+	s.loading = true
+
+	s.Reload()
+	assert.Equal(t, DBLoading, s.DBStatus().State)
+	assert.True(t, s.loading)
+	assert.False(t, s.IsInitialized())
+}
+
+// Helpers...
+
+type testProvider struct {
+	vulnerability.Provider
+
+	closed chan struct{}
+}
+
+func newTestProvider() *testProvider {
+	return &testProvider{closed: make(chan struct{})}
+}
+
+func (p *testProvider) Close() error {
+	close(p.closed)
+	return nil
+}
@@ -98,6 +98,18 @@ func (t *table) dedup() {
 	t.Rows = rr
 }
 
+// filter returns a table with the rows matching a given predicate.
+func (t *table) filter(keep func(Row) bool) *table {
+	ft := newTable()
+	for _, r := range t.Rows {
+		if keep(r) {
+			ft.addRow(r)
+		}
+	}
+
+	return ft
+}
+
 func (t *table) addRow(r Row) {
 	t.Rows = append(t.Rows, r)
 }
@@ -16,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	di "k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
 )
@@ -28,6 +29,7 @@ const (
 // Factory tracks various resource informers.
 type Factory struct {
 	factories  map[string]di.DynamicSharedInformerFactory
+	gvrs       map[string]sets.Set[*client.GVR]
 	client     client.Connection
 	stopChan   chan struct{}
 	forwarders Forwarders
@@ -39,6 +41,7 @@ func NewFactory(clt client.Connection) *Factory {
 	return &Factory{
 		client:     clt,
 		factories:  make(map[string]di.DynamicSharedInformerFactory),
+		gvrs:       make(map[string]sets.Set[*client.GVR]),
 		forwarders: NewForwarders(),
 	}
 }
@@ -68,6 +71,9 @@ func (f *Factory) Terminate() {
 	for k := range f.factories {
 		delete(f.factories, k)
 	}
+	for k := range f.gvrs {
+		delete(f.gvrs, k)
+	}
 	f.forwarders.DeleteAll()
 }
 
@@ -262,13 +268,24 @@ func (f *Factory) ForResource(ns string, gvr *client.GVR) (informers.GenericInfo
 		return inf, nil
 	}
 
-	f.mx.RLock()
-	defer f.mx.RUnlock()
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	f.trackGVR(ns, gvr)
 	fact.Start(f.stopChan)
 
 	return inf, nil
 }
 
+func (f *Factory) trackGVR(ns string, gvr *client.GVR) {
+	if client.IsClusterWide(ns) {
+		ns = client.BlankNamespace
+	}
+	if _, ok := f.gvrs[ns]; !ok {
+		f.gvrs[ns] = sets.New[*client.GVR]()
+	}
+	f.gvrs[ns].Insert(gvr)
+}
+
 func (f *Factory) ensureFactory(ns string) (di.DynamicSharedInformerFactory, error) {
 	if client.IsClusterWide(ns) {
 		ns = client.BlankNamespace
@@ -310,6 +327,15 @@ func (f *Factory) DeleteForwarder(path string) {
 	)
 }
 
+// ReplaceForwarder re-registers a portforward whose target pod changed.
+func (f *Factory) ReplaceForwarder(oldID string, pf Forwarder) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	delete(f.forwarders, oldID)
+	f.forwarders[pf.ID()] = pf
+}
+
 // Forwarders returns all portforwards.
 func (f *Factory) Forwarders() Forwarders {
 	f.mx.RLock()
@@ -332,6 +358,9 @@ func (f *Factory) ForwarderFor(path string) (Forwarder, bool) {
 // BOZO!! Review!!!
 func (f *Factory) ValidatePortForwards() {
 	for k, fwd := range f.forwarders {
+		if fl, ok := fwd.(Follower); ok && fl.IsFollowing() {
+			continue
+		}
 		tokens := strings.Split(k, ":")
 		if len(tokens) != 2 {
 			slog.Error("Invalid port-forward key", slogs.Key, k)
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package watch
+
+import (
+	"log/slog"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/slogs"
+)
+
+// Fleet tracks informer factories across several kubeconfig contexts.
+type Fleet struct {
+	config    *client.Config
+	factories map[string]*Factory
+	mx        sync.Mutex
+}
+
+// NewFleet returns a new fleet for the given kubeconfig.
+func NewFleet(cfg *client.Config) *Fleet {
+	return &Fleet{
+		config:    cfg,
+		factories: make(map[string]*Factory),
+	}
+}
+
+// FactoryFor returns a factory for the given context.
+func (f *Fleet) FactoryFor(ct string) (*Factory, error) {
+	f.mx.Lock()
+	fac, ok := f.factories[ct]
+	f.mx.Unlock()
+	if ok {
+		return fac, nil
+	}
+
+	cfg, err := f.config.ContextConfig(ct)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := client.InitConnection(cfg, slog.Default())
+	if err != nil {
+		return nil, err
+	}
+	fac = NewFactory(conn)
+
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	if f0, ok := f.factories[ct]; ok {
+		return f0, nil
+	}
+	f.factories[ct] = fac
+	slog.Debug("Fleet factory created", slogs.Context, ct)
+
+	return fac, nil
+}
+
+// Start starts all fleet factories.
+func (f *Fleet) Start(ns string) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	for _, fac := range f.factories {
+		fac.Start(ns)
+	}
+}
+
+// Terminate terminates all fleet factories.
+func (f *Fleet) Terminate() {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	for _, fac := range f.factories {
+		fac.Terminate()
+	}
+}
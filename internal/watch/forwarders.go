@@ -50,6 +50,12 @@ type Forwarder interface {
 	HasPortMapping(string) bool
 }
 
+// Follower represents a port forwarder that follows pod replacements.
+type Follower interface {
+	// IsFollowing returns true if the forward reconnects to replacement pods.
+	IsFollowing() bool
+}
+
 // Forwarders tracks active port forwards.
 type Forwarders map[string]Forwarder
 
@@ -0,0 +1,228 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package watch
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/k9s/internal/snapshot"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/discovery"
+)
+
+const (
+	snapshotLogTail     int64 = 200
+	snapshotCallTimeout       = 10 * time.Second
+	snapshotWorkers           = 10
+)
+
+// snapshotGVRs tracks resources always captured so snapshots can be navigated.
+var snapshotGVRs = []*client.GVR{
+	client.NsGVR,
+	client.NodeGVR,
+	client.PodGVR,
+	client.SvcGVR,
+	client.EpGVR,
+	client.CmGVR,
+	client.SecGVR,
+	client.SaGVR,
+	client.PvcGVR,
+	client.PvGVR,
+	client.DpGVR,
+	client.RsGVR,
+	client.StsGVR,
+	client.DsGVR,
+	client.JobGVR,
+	client.CjGVR,
+	client.IngGVR,
+	client.EvGVR,
+	client.NewGVR("v1/events"),
+}
+
+// Snapshot captures the informer caches, events, logs tail and metrics into an archive.
+// Well known resources are also listed in the given namespace when not already watched.
+func (f *Factory) Snapshot(ctx context.Context, ns string) (*snapshot.Archive, error) {
+	ns = client.CleanseNamespace(ns)
+	a, err := f.newArchive(ns)
+	if err != nil {
+		return nil, err
+	}
+	f.snapshotInformers(a)
+
+	gvrs := slices.Clone(snapshotGVRs)
+	if f.client.HasMetrics() {
+		gvrs = append(gvrs, client.NmxGVR, client.PmxGVR)
+	}
+	if err := f.snapshotList(ctx, a, ns, gvrs); err != nil {
+		return nil, err
+	}
+	f.snapshotLogs(ctx, a)
+
+	return a, nil
+}
+
+func (f *Factory) newArchive(ns string) (*snapshot.Archive, error) {
+	cfg := f.client.Config()
+	ct, err := cfg.CurrentContextName()
+	if err != nil {
+		return nil, err
+	}
+	cl, err := cfg.CurrentClusterName()
+	if err != nil {
+		return nil, err
+	}
+	a := snapshot.NewArchive(snapshot.Manifest{
+		Context:   ct,
+		Cluster:   cl,
+		Namespace: ns,
+		CreatedAt: time.Now(),
+	})
+	if info, err := f.client.ServerVersion(); err == nil {
+		a.Manifest.Server = info
+	}
+
+	dial, err := f.client.CachedDiscovery()
+	if err != nil {
+		return nil, err
+	}
+	gg, rr, err := dial.ServerGroupsAndResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, err
+	}
+	for _, g := range gg {
+		a.Groups.Groups = append(a.Groups.Groups, *g)
+	}
+	a.Resources = rr
+
+	return a, nil
+}
+
+func (f *Factory) snapshotInformers(a *snapshot.Archive) {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	for ns, gvrs := range f.gvrs {
+		fac, ok := f.factories[ns]
+		if !ok {
+			continue
+		}
+		for gvr := range gvrs {
+			inf := fac.ForResource(gvr.GVR())
+			if !inf.Informer().HasSynced() {
+				continue
+			}
+			oo, err := inf.Lister().List(labels.Everything())
+			if err != nil {
+				slog.Warn("Snapshot informer list failed", slogs.GVR, gvr, slogs.Error, err)
+				continue
+			}
+			a.Add(gvr.GVR(), toUnstructured(oo)...)
+		}
+	}
+}
+
+func (f *Factory) snapshotList(ctx context.Context, a *snapshot.Archive, ns string, gvrs []*client.GVR) error {
+	dial, err := f.client.DynDial()
+	if err != nil {
+		return err
+	}
+	for _, gvr := range gvrs {
+		if len(a.List(gvr.GVR())) > 0 {
+			continue
+		}
+		res, ok := a.ResourceFor(gvr.GVR())
+		if !ok {
+			continue
+		}
+		cns := ns
+		if !res.Namespaced {
+			cns = client.BlankNamespace
+		}
+		if ok, err := f.client.CanI(cns, gvr, "", client.ListAccess); !ok || err != nil {
+			continue
+		}
+		cctx, cancel := context.WithTimeout(ctx, snapshotCallTimeout)
+		ll, err := dial.Resource(gvr.GVR()).Namespace(cns).List(cctx, metav1.ListOptions{})
+		cancel()
+		if err != nil {
+			slog.Warn("Snapshot list failed", slogs.GVR, gvr, slogs.Error, err)
+			continue
+		}
+		oo := make([]*unstructured.Unstructured, 0, len(ll.Items))
+		for i := range ll.Items {
+			oo = append(oo, &ll.Items[i])
+		}
+		a.Add(gvr.GVR(), oo...)
+	}
+
+	return nil
+}
+
+func (f *Factory) snapshotLogs(ctx context.Context, a *snapshot.Archive) {
+	dial, err := f.client.DialLogs()
+	if err != nil {
+		slog.Warn("Snapshot logs dial failed", slogs.Error, err)
+		return
+	}
+
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, snapshotWorkers)
+	)
+	for _, o := range a.List(client.PodGVR.GVR()) {
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.Object, &po); err != nil {
+			continue
+		}
+		cc := make([]string, 0, len(po.Spec.InitContainers)+len(po.Spec.Containers))
+		for i := range po.Spec.InitContainers {
+			cc = append(cc, po.Spec.InitContainers[i].Name)
+		}
+		for i := range po.Spec.Containers {
+			cc = append(cc, po.Spec.Containers[i].Name)
+		}
+		for _, co := range cc {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(ns, n, co string) {
+				defer func() { <-sem; wg.Done() }()
+				cctx, cancel := context.WithTimeout(ctx, snapshotCallTimeout)
+				defer cancel()
+				tail := snapshotLogTail
+				bb, err := dial.CoreV1().Pods(ns).GetLogs(n, &v1.PodLogOptions{
+					Container:  co,
+					TailLines:  &tail,
+					Timestamps: true,
+				}).Do(cctx).Raw()
+				if err != nil || len(bytes.TrimSpace(bb)) == 0 {
+					return
+				}
+				a.AddLogs(ns, n, co, bb)
+			}(po.Namespace, po.Name, co)
+		}
+	}
+	wg.Wait()
+}
+
+func toUnstructured(oo []runtime.Object) []*unstructured.Unstructured {
+	uu := make([]*unstructured.Unstructured, 0, len(oo))
+	for _, o := range oo {
+		if u, ok := o.(*unstructured.Unstructured); ok {
+			uu = append(uu, u)
+		}
+	}
+
+	return uu
+}
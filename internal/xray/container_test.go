@@ -109,7 +109,9 @@ func makeFactory() testFactory {
 }
 
 type testFactory struct {
-	rows map[*client.GVR][]runtime.Object
+	rows  map[*client.GVR][]runtime.Object
+	gets  map[*client.GVR]int
+	lists map[*client.GVR]int
 }
 
 var _ dao.Factory = testFactory{}
@@ -119,6 +121,9 @@ func (f testFactory) Client() client.Connection {
 }
 
 func (f testFactory) Get(gvr *client.GVR, _ string, _ bool, _ labels.Selector) (runtime.Object, error) {
+	if f.gets != nil {
+		f.gets[gvr]++
+	}
 	oo, ok := f.rows[gvr]
 	if ok && len(oo) > 0 {
 		return oo[0], nil
@@ -127,6 +132,9 @@ func (f testFactory) Get(gvr *client.GVR, _ string, _ bool, _ labels.Selector) (
 }
 
 func (f testFactory) List(gvr *client.GVR, _ string, _ bool, _ labels.Selector) ([]runtime.Object, error) {
+	if f.lists != nil {
+		f.lists[gvr]++
+	}
 	oo, ok := f.rows[gvr]
 	if ok {
 		return oo, nil
@@ -0,0 +1,237 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+// ExportFormat represents a tree export format.
+type ExportFormat string
+
+const (
+	// DOTFormat exports a Graphviz DOT graph.
+	DOTFormat ExportFormat = "dot"
+
+	// MermaidFormat exports a Mermaid flowchart.
+	MermaidFormat ExportFormat = "mermaid"
+
+	// JSONFormat exports a json tree.
+	JSONFormat ExportFormat = "json"
+)
+
+// ExportFormats tracks all supported export formats.
+var ExportFormats = []ExportFormat{DOTFormat, MermaidFormat, JSONFormat}
+
+// Ext returns the export file extension.
+func (f ExportFormat) Ext() string {
+	switch f {
+	case DOTFormat:
+		return ".dot"
+	case MermaidFormat:
+		return ".mmd"
+	default:
+		return ".json"
+	}
+}
+
+// Export writes the tree out in the given format.
+func (t *TreeNode) Export(w io.Writer, f ExportFormat) error {
+	switch f {
+	case DOTFormat:
+		return t.exportDOT(w)
+	case MermaidFormat:
+		return t.exportMermaid(w)
+	case JSONFormat:
+		return t.exportJSON(w)
+	default:
+		return fmt.Errorf("unsupported export format %q", f)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// graph represents a tree as a graph where resources shared by several
+// parents, i.e. secrets or service accounts, map to a single node.
+type graph struct {
+	nodes []*TreeNode
+	ids   map[string]string
+	edges [][2]string
+	seen  map[[2]string]struct{}
+}
+
+func newGraph(root *TreeNode) *graph {
+	g := graph{
+		ids:  make(map[string]string),
+		seen: make(map[[2]string]struct{}),
+	}
+	g.walk(root)
+
+	return &g
+}
+
+func (g *graph) walk(n *TreeNode) {
+	id := g.nodeID(n)
+	for _, c := range n.Children {
+		e := [2]string{id, g.nodeID(c)}
+		if _, ok := g.seen[e]; !ok {
+			g.seen[e] = struct{}{}
+			g.edges = append(g.edges, e)
+		}
+		g.walk(c)
+	}
+}
+
+func (g *graph) nodeID(n *TreeNode) string {
+	k := n.GVR.String() + PathSeparator + n.ID
+	if id, ok := g.ids[k]; ok {
+		return id
+	}
+	id := fmt.Sprintf("n%d", len(g.nodes))
+	g.ids[k] = id
+	g.nodes = append(g.nodes, n)
+
+	return id
+}
+
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+func (t *TreeNode) exportDOT(w io.Writer) error {
+	g := newGraph(t)
+
+	var b strings.Builder
+	b.WriteString("digraph xray {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=rounded, fontname=\"Helvetica\"];\n")
+	for i, n := range g.nodes {
+		ll := n.exportLabel()
+		for j := range ll {
+			ll[j] = dotEscaper.Replace(ll[j])
+		}
+		fmt.Fprintf(&b, "  n%d [label=\"%s\"", i, strings.Join(ll, `\n`))
+		switch n.Extras[StatusKey] {
+		case ToastStatus:
+			b.WriteString(", color=orangered, fontcolor=orangered")
+		case MissingRefStatus:
+			b.WriteString(", color=orange, style=\"rounded,dashed\"")
+		}
+		b.WriteString("];\n")
+	}
+	for _, e := range g.edges {
+		fmt.Fprintf(&b, "  %s -> %s;\n", e[0], e[1])
+	}
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+func (t *TreeNode) exportMermaid(w io.Writer) error {
+	g := newGraph(t)
+
+	var (
+		b              strings.Builder
+		toasts, norefs []string
+	)
+	b.WriteString("flowchart LR\n")
+	for i, n := range g.nodes {
+		ll := n.exportLabel()
+		for j := range ll {
+			ll[j] = strings.ReplaceAll(ll[j], `"`, "#quot;")
+		}
+		fmt.Fprintf(&b, "  n%d[\"%s\"]\n", i, strings.Join(ll, "<br/>"))
+		switch n.Extras[StatusKey] {
+		case ToastStatus:
+			toasts = append(toasts, fmt.Sprintf("n%d", i))
+		case MissingRefStatus:
+			norefs = append(norefs, fmt.Sprintf("n%d", i))
+		}
+	}
+	for _, e := range g.edges {
+		fmt.Fprintf(&b, "  %s --> %s\n", e[0], e[1])
+	}
+	if len(toasts) > 0 {
+		b.WriteString("  classDef toast stroke:#ff4500,color:#ff4500\n")
+		fmt.Fprintf(&b, "  class %s toast\n", strings.Join(toasts, ","))
+	}
+	if len(norefs) > 0 {
+		b.WriteString("  classDef noref stroke:#ffa500,stroke-dasharray:4\n")
+		fmt.Fprintf(&b, "  class %s noref\n", strings.Join(norefs, ","))
+	}
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+type jsonNode struct {
+	Kind      string     `json:"kind"`
+	GVR       string     `json:"gvr"`
+	Namespace string     `json:"namespace,omitempty"`
+	Name      string     `json:"name"`
+	Status    string     `json:"status,omitempty"`
+	Info      string     `json:"info,omitempty"`
+	Children  []jsonNode `json:"children,omitempty"`
+}
+
+func (t *TreeNode) toJSONNode() jsonNode {
+	ns, n := client.Namespaced(t.ID)
+	if ns == client.ClusterScope {
+		ns = ""
+	}
+	jn := jsonNode{
+		Kind:      t.kind(),
+		GVR:       t.GVR.String(),
+		Namespace: ns,
+		Name:      n,
+		Status:    t.Extras[StatusKey],
+		Info:      t.Extras[InfoKey],
+	}
+	for _, c := range t.Children {
+		jn.Children = append(jn.Children, c.toJSONNode())
+	}
+
+	return jn
+}
+
+func (t *TreeNode) exportJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(t.toJSONNode())
+}
+
+func (t *TreeNode) kind() string {
+	if k := category(t.GVR); k != "" {
+		return k
+	}
+
+	return t.GVR.R()
+}
+
+func (t *TreeNode) exportLabel() []string {
+	_, n := client.Namespaced(t.ID)
+	ll := []string{n}
+	if !t.IsRoot() {
+		ll = []string{t.kind(), n}
+	}
+	if info, ok := t.Extras[InfoKey]; ok {
+		ll = append(ll, info)
+	}
+	switch t.Extras[StatusKey] {
+	case ToastStatus:
+		ll = append(ll, toast)
+	case MissingRefStatus:
+		ll = append(ll, toast+"_REF")
+	}
+
+	return ll
+}
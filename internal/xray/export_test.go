@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTreeNodeExport(t *testing.T) {
+	uu := map[string]struct {
+		f xray.ExportFormat
+		e string
+	}{
+		"dot": {
+			f: xray.DOTFormat,
+			e: `digraph xray {
+  rankdir=LR;
+  node [shape=box, style=rounded, fontname="Helvetica"];
+  n0 [label="deployments"];
+  n1 [label="deployments\nfred"];
+  n2 [label="pods\np1"];
+  n3 [label="secrets\ns1"];
+  n4 [label="pods\np2\nTOAST", color=orangered, fontcolor=orangered];
+  n5 [label="configmaps\n\"cm1\"\nTOAST_REF", color=orange, style="rounded,dashed"];
+  n0 -> n1;
+  n1 -> n2;
+  n2 -> n3;
+  n1 -> n4;
+  n4 -> n3;
+  n4 -> n5;
+}
+`,
+		},
+		"mermaid": {
+			f: xray.MermaidFormat,
+			e: `flowchart LR
+  n0["deployments"]
+  n1["deployments<br/>fred"]
+  n2["pods<br/>p1"]
+  n3["secrets<br/>s1"]
+  n4["pods<br/>p2<br/>TOAST"]
+  n5["configmaps<br/>#quot;cm1#quot;<br/>TOAST_REF"]
+  n0 --> n1
+  n1 --> n2
+  n2 --> n3
+  n1 --> n4
+  n4 --> n3
+  n4 --> n5
+  classDef toast stroke:#ff4500,color:#ff4500
+  class n4 toast
+  classDef noref stroke:#ffa500,stroke-dasharray:4
+  class n5 noref
+`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var b bytes.Buffer
+			require.NoError(t, exportTree().Export(&b, u.f))
+			assert.Equal(t, u.e, b.String())
+		})
+	}
+}
+
+func TestTreeNodeExportJSON(t *testing.T) {
+	var b bytes.Buffer
+	require.NoError(t, exportTree().Export(&b, xray.JSONFormat))
+
+	var root struct {
+		Name     string
+		Children []struct {
+			Namespace, Name string
+			Children        []struct {
+				Name, Status string
+				Children     []struct{ Name string }
+			}
+		}
+	}
+	require.NoError(t, json.Unmarshal(b.Bytes(), &root))
+	assert.Equal(t, "deployments", root.Name)
+	require.Len(t, root.Children, 1)
+	assert.Equal(t, "default", root.Children[0].Namespace)
+	assert.Equal(t, "fred", root.Children[0].Name)
+	require.Len(t, root.Children[0].Children, 2)
+	assert.Equal(t, "p2", root.Children[0].Children[1].Name)
+	assert.Equal(t, xray.ToastStatus, root.Children[0].Children[1].Status)
+	assert.Len(t, root.Children[0].Children[1].Children, 2)
+}
+
+func TestTreeNodeExportUnsupported(t *testing.T) {
+	var b bytes.Buffer
+	require.Error(t, exportTree().Export(&b, xray.ExportFormat("bozo")))
+}
+
+// Helpers...
+
+func exportTree() *xray.TreeNode {
+	root := xray.NewTreeNode(client.DpGVR, "deployments")
+	dp := xray.NewTreeNode(client.DpGVR, "default/fred")
+	p1 := xray.NewTreeNode(client.PodGVR, "default/p1")
+	p1.Add(xray.NewTreeNode(client.SecGVR, "default/s1"))
+	p2 := xray.NewTreeNode(client.PodGVR, "default/p2")
+	p2.Extras[xray.StatusKey] = xray.ToastStatus
+	p2.Add(xray.NewTreeNode(client.SecGVR, "default/s1"))
+	cm := xray.NewTreeNode(client.CmGVR, `default/"cm1"`)
+	cm.Extras[xray.StatusKey] = xray.MissingRefStatus
+	p2.Add(cm)
+	dp.Add(p1)
+	dp.Add(p2)
+	root.Add(dp)
+
+	return root
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// HorizontalPodAutoscaler represents an xray renderer.
+type HorizontalPodAutoscaler struct{}
+
+// Render renders an xray node.
+func (h *HorizontalPodAutoscaler) Render(ctx context.Context, _ string, o any) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Unstructured, but got %T", o)
+	}
+	var hpa autoscalingv1.HorizontalPodAutoscaler
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &hpa)
+	if err != nil {
+		return err
+	}
+
+	parent, ok := ctx.Value(KeyParent).(*TreeNode)
+	if !ok {
+		return fmt.Errorf("expecting a TreeNode but got %T", ctx.Value(KeyParent))
+	}
+
+	root := NewTreeNode(client.HpaGVR, client.FQN(hpa.Namespace, hpa.Name))
+	ref := hpa.Spec.ScaleTargetRef
+	if gvr, ok := workloadGVR(ref.Kind); ok {
+		if _, err := addResource(ctx, root, gvr, client.FQN(hpa.Namespace, ref.Name)); err != nil {
+			return err
+		}
+	}
+	addToNamespace(parent, hpa.Namespace, root)
+
+	return h.validate(root, &hpa)
+}
+
+func (*HorizontalPodAutoscaler) validate(root *TreeNode, hpa *autoscalingv1.HorizontalPodAutoscaler) error {
+	root.Extras[StatusKey] = OkStatus
+	if root.IsLeaf() || hasMissingRefs(root) || hpa.Status.CurrentReplicas >= hpa.Spec.MaxReplicas {
+		root.Extras[StatusKey] = ToastStatus
+	}
+	var minReplicas int32 = 1
+	if hpa.Spec.MinReplicas != nil {
+		minReplicas = *hpa.Spec.MinReplicas
+	}
+	root.Extras[InfoKey] = fmt.Sprintf("%d/%d/%d", hpa.Status.CurrentReplicas, minReplicas, hpa.Spec.MaxReplicas)
+
+	return nil
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestHorizontalPodAutoscalerRender(t *testing.T) {
+	uu := map[string]struct {
+		rows   map[*client.GVR][]runtime.Object
+		pods   int
+		status string
+	}{
+		"plain": {
+			rows: map[*client.GVR][]runtime.Object{
+				client.DpGVR:  {load(t, "dp")},
+				client.PodGVR: {load(t, "po")},
+			},
+			pods:   1,
+			status: xray.OkStatus,
+		},
+		"missingTarget": {
+			status: xray.ToastStatus,
+		},
+	}
+
+	var re xray.HorizontalPodAutoscaler
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := makeFactory()
+			f.rows = u.rows
+			root := xray.NewTreeNode(client.HpaGVR, "horizontalpodautoscalers")
+			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+			ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+			require.NoError(t, re.Render(ctx, "", load(t, "hpa")))
+			require.Equal(t, 1, root.CountChildren())
+			hpa := root.Children[0].Children[0]
+			assert.Equal(t, u.status, hpa.Extras[xray.StatusKey])
+			assert.Equal(t, "1/1/5", hpa.Extras[xray.InfoKey])
+			require.Equal(t, 1, hpa.CountChildren())
+			assert.Equal(t, client.DpGVR, hpa.Children[0].GVR)
+			assert.Equal(t, u.pods, hpa.Children[0].CountChildren())
+		})
+	}
+}
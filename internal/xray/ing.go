@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	netv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Ingress represents an xray renderer.
+type Ingress struct{}
+
+// Render renders an xray node.
+func (i *Ingress) Render(ctx context.Context, _ string, o any) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Unstructured, but got %T", o)
+	}
+	var ing netv1.Ingress
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &ing)
+	if err != nil {
+		return err
+	}
+
+	parent, ok := ctx.Value(KeyParent).(*TreeNode)
+	if !ok {
+		return fmt.Errorf("expecting a TreeNode but got %T", ctx.Value(KeyParent))
+	}
+
+	root := NewTreeNode(client.IngGVR, client.FQN(ing.Namespace, ing.Name))
+	routes := ingressRoutes(&ing)
+	svcs := make([]string, 0, len(routes))
+	for svc := range routes {
+		svcs = append(svcs, svc)
+	}
+	slices.Sort(svcs)
+	for _, svc := range svcs {
+		n, err := addResource(ctx, root, client.SvcGVR, client.FQN(ing.Namespace, svc))
+		if err != nil {
+			return err
+		}
+		n.Extras[InfoKey] = strings.Join(routes[svc], ",")
+	}
+	addToNamespace(parent, ing.Namespace, root)
+
+	return i.validate(root, &ing)
+}
+
+func (*Ingress) validate(root *TreeNode, ing *netv1.Ingress) error {
+	root.Extras[StatusKey] = OkStatus
+	if hasMissingRefs(root) {
+		root.Extras[StatusKey] = ToastStatus
+	}
+	hosts := make([]string, 0, len(ing.Spec.Rules))
+	for _, r := range ing.Spec.Rules {
+		if r.Host != "" && !slices.Contains(hosts, r.Host) {
+			hosts = append(hosts, r.Host)
+		}
+	}
+	if len(hosts) > 0 {
+		root.Extras[InfoKey] = strings.Join(hosts, ",")
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ingressRoutes returns the ingress backend services along with their routes.
+func ingressRoutes(ing *netv1.Ingress) map[string][]string {
+	routes := make(map[string][]string)
+	add := func(b *netv1.IngressBackend, route string) {
+		if b == nil || b.Service == nil {
+			return
+		}
+		if !slices.Contains(routes[b.Service.Name], route) {
+			routes[b.Service.Name] = append(routes[b.Service.Name], route)
+		}
+	}
+
+	add(ing.Spec.DefaultBackend, "*")
+	for _, r := range ing.Spec.Rules {
+		if r.HTTP == nil {
+			continue
+		}
+		host := r.Host
+		if host == "" {
+			host = "*"
+		}
+		for _, p := range r.HTTP.Paths {
+			add(&p.Backend, host+p.Path)
+		}
+	}
+
+	return routes
+}
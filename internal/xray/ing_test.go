@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestIngressRender(t *testing.T) {
+	uu := map[string]struct {
+		rows         map[*client.GVR][]runtime.Object
+		status, info string
+	}{
+		"plain": {
+			rows: map[*client.GVR][]runtime.Object{
+				client.SvcGVR: {load(t, "svc")},
+				client.PodGVR: {load(t, "po")},
+			},
+			status: xray.OkStatus,
+			info:   "*,fred.example.com/api",
+		},
+		"missingSvc": {
+			status: xray.ToastStatus,
+			info:   "*,fred.example.com/api",
+		},
+	}
+
+	var re xray.Ingress
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := makeFactory()
+			f.rows = u.rows
+			root := xray.NewTreeNode(client.IngGVR, "ingresses")
+			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+			ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+			require.NoError(t, re.Render(ctx, "", load(t, "ing")))
+			require.Equal(t, 1, root.CountChildren())
+			ing := root.Children[0].Children[0]
+			assert.Equal(t, u.status, ing.Extras[xray.StatusKey])
+			assert.Equal(t, "fred.example.com", ing.Extras[xray.InfoKey])
+			require.Equal(t, 1, ing.CountChildren())
+			assert.Equal(t, client.SvcGVR, ing.Children[0].GVR)
+			assert.Equal(t, u.info, ing.Children[0].Extras[xray.InfoKey])
+		})
+	}
+}
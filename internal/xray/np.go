@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	netv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// NetworkPolicy represents an xray renderer.
+type NetworkPolicy struct{}
+
+// Render renders an xray node.
+func (n *NetworkPolicy) Render(ctx context.Context, _ string, o any) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Unstructured, but got %T", o)
+	}
+	var np netv1.NetworkPolicy
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &np)
+	if err != nil {
+		return err
+	}
+
+	parent, ok := ctx.Value(KeyParent).(*TreeNode)
+	if !ok {
+		return fmt.Errorf("expecting a TreeNode but got %T", ctx.Value(KeyParent))
+	}
+
+	root := NewTreeNode(client.NpGVR, client.FQN(np.Namespace, np.Name))
+	oo, err := selectPods(ctx, np.Namespace, &np.Spec.PodSelector)
+	if err != nil {
+		return err
+	}
+	if err := graftPods(ctx, root, np.Namespace, oo); err != nil {
+		return err
+	}
+	addToNamespace(parent, np.Namespace, root)
+
+	return n.validate(root, &np)
+}
+
+func (*NetworkPolicy) validate(root *TreeNode, np *netv1.NetworkPolicy) error {
+	root.Extras[StatusKey] = OkStatus
+	tt := make([]string, 0, len(np.Spec.PolicyTypes))
+	for _, t := range np.Spec.PolicyTypes {
+		tt = append(tt, string(t))
+	}
+	if len(tt) > 0 {
+		root.Extras[InfoKey] = strings.Join(tt, ",")
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestNetworkPolicyRender(t *testing.T) {
+	f := makeFactory()
+	f.rows = map[*client.GVR][]runtime.Object{client.PodGVR: {load(t, "po")}}
+
+	var re xray.NetworkPolicy
+	root := xray.NewTreeNode(client.NpGVR, "networkpolicies")
+	ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+	ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+	require.NoError(t, re.Render(ctx, "", load(t, "np")))
+	require.Equal(t, 1, root.CountChildren())
+	np := root.Children[0].Children[0]
+	assert.Equal(t, xray.OkStatus, np.Extras[xray.StatusKey])
+	assert.Equal(t, "Ingress,Egress", np.Extras[xray.InfoKey])
+	require.Equal(t, 1, np.CountChildren())
+	assert.Equal(t, client.PodGVR, np.Children[0].GVR)
+}
@@ -0,0 +1,267 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/slogs"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// ownedGVRs tracks namespaced workloads commonly owned by operators.
+var ownedGVRs = []*client.GVR{
+	client.DpGVR,
+	client.StsGVR,
+	client.DsGVR,
+	client.RsGVR,
+	client.JobGVR,
+	client.PodGVR,
+	client.SvcGVR,
+	client.PvcGVR,
+}
+
+var (
+	// healthyConditions tracks conditions that must be true to be healthy.
+	healthyConditions = sets.New("Ready", "Available", "Healthy", "Synced", "Established", "Reconciled")
+
+	// unhealthyConditions tracks conditions that must not be true to be healthy.
+	unhealthyConditions = sets.New("Degraded", "Failed", "Stalled", "ReplicaFailure", "InvalidSpec")
+)
+
+// Owner represents an xray renderer for resources with no dedicated renderer.
+// The tree is derived from the resources ownerReferences.
+type Owner struct{}
+
+// Render renders an xray node.
+func (*Owner) Render(ctx context.Context, _ string, o any) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Unstructured, but got %T", o)
+	}
+	parent, ok := ctx.Value(KeyParent).(*TreeNode)
+	if !ok {
+		return fmt.Errorf("expecting a TreeNode but got %T", ctx.Value(KeyParent))
+	}
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return fmt.Errorf("expecting a factory but got %T", ctx.Value(internal.KeyFactory))
+	}
+
+	gvr, ok := gvrFor(raw.GetAPIVersion(), raw.GetKind())
+	if !ok {
+		return fmt.Errorf("no resource found for %s", raw.GroupVersionKind())
+	}
+	root := NewTreeNode(gvr, objectFQN(raw))
+	conditionsStatus(root, raw)
+	w := ownerWalk{f: f, seen: sets.New(raw.GetUID()), kinds: sets.New[*client.GVR]()}
+	if err := w.addOwned(ctx, root, raw); err != nil {
+		return err
+	}
+	if raw.GetNamespace() == "" {
+		parent.Add(root)
+		return nil
+	}
+	addToNamespace(parent, raw.GetNamespace(), root)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ownerWalk tracks the resources visited while walking owner references.
+type ownerWalk struct {
+	f     dao.Factory
+	seen  sets.Set[types.UID]
+	kinds sets.Set[*client.GVR]
+}
+
+// addOwned adds all resources owned by a given resource to a given node.
+// Known workloads are rendered with their own renderers.
+func (w *ownerWalk) addOwned(ctx context.Context, n *TreeNode, o *unstructured.Unstructured) error {
+	ns := o.GetNamespace()
+	if ns == "" {
+		ns = client.ClusterScope
+	}
+	for _, gvr := range w.candidates(o) {
+		oo, err := w.f.List(gvr, ns, true, labels.Everything())
+		if err != nil {
+			slog.Debug("Owned list failed",
+				slogs.GVR, gvr,
+				slogs.Error, err,
+			)
+			continue
+		}
+		for _, c := range oo {
+			u, ok := c.(*unstructured.Unstructured)
+			if !ok || w.seen.Has(u.GetUID()) || !isOwnedBy(u, o.GetUID()) {
+				continue
+			}
+			w.seen.Insert(u.GetUID())
+			w.kinds.Insert(gvr)
+			if err := w.addOwnedNode(ctx, n, gvr, u); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (w *ownerWalk) addOwnedNode(ctx context.Context, n *TreeNode, gvr *client.GVR, u *unstructured.Unstructured) error {
+	if gvr == client.PodGVR {
+		return graftPods(ctx, n, u.GetNamespace(), []runtime.Object{u})
+	}
+	if re := workloadRenderer(gvr); re != nil {
+		return graft(ctx, n, re, u.GetNamespace(), u)
+	}
+
+	c := NewTreeNode(gvr, objectFQN(u))
+	conditionsStatus(c, u)
+	n.Add(c)
+
+	return w.addOwned(ctx, c, u)
+}
+
+// candidates returns the resources that might be owned by a given resource
+// ie resources it references, kinds from its api group and kinds owned so far.
+// Namespaced resources also check common workloads. Candidates are bound to
+// the resource scope except for explicit references so cluster scoped
+// resources do not list namespaced resources cluster wide.
+func (w *ownerWalk) candidates(o *unstructured.Unstructured) []*client.GVR {
+	namespaced := o.GetNamespace() != ""
+	gvrs := sets.New[*client.GVR]()
+	if namespaced {
+		gvrs.Insert(ownedGVRs...)
+	}
+	if g := o.GroupVersionKind().Group; g != "" {
+		for _, gvr := range dao.MetaAccess.AllGVRs() {
+			if gvr.G() != g {
+				continue
+			}
+			if m, err := dao.MetaAccess.MetaFor(gvr); err == nil && dao.IsK8sMeta(m) {
+				gvrs.Insert(gvr)
+			}
+		}
+	}
+	gvrs = gvrs.Union(w.kinds)
+
+	cc := make([]*client.GVR, 0, gvrs.Len())
+	for gvr := range gvrs {
+		if ok, err := dao.MetaAccess.IsNamespaced(gvr); err != nil || ok != namespaced {
+			continue
+		}
+		cc = append(cc, gvr)
+	}
+	walkRefs(o.Object, func(apiVersion, kind string) {
+		gvr, ok := gvrFor(apiVersion, kind)
+		if !ok || slices.Contains(cc, gvr) {
+			return
+		}
+		// Namespaced resources can't own cluster scoped resources.
+		if namespaced {
+			if ok, err := dao.MetaAccess.IsNamespaced(gvr); err == nil && !ok {
+				return
+			}
+		}
+		cc = append(cc, gvr)
+	})
+	slices.SortFunc(cc, func(a, b *client.GVR) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	return cc
+}
+
+// walkRefs visits all object references ie apiVersion/kind pairs.
+func walkRefs(o any, fn func(apiVersion, kind string)) {
+	switch v := o.(type) {
+	case map[string]any:
+		apiVersion, ok1 := v["apiVersion"].(string)
+		kind, ok2 := v["kind"].(string)
+		if ok1 && ok2 {
+			fn(apiVersion, kind)
+		}
+		for k, c := range v {
+			if k == "metadata" {
+				continue
+			}
+			walkRefs(c, fn)
+		}
+	case []any:
+		for _, c := range v {
+			walkRefs(c, fn)
+		}
+	}
+}
+
+// gvrFor returns the resource for a given api version and kind.
+func gvrFor(apiVersion, kind string) (*client.GVR, bool) {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return nil, false
+	}
+	gvr, _, ok := dao.MetaAccess.GVK2GVR(gv, kind)
+
+	return gvr, ok
+}
+
+func isOwnedBy(o *unstructured.Unstructured, uid types.UID) bool {
+	for _, ref := range o.GetOwnerReferences() {
+		if ref.UID == uid {
+			return true
+		}
+	}
+
+	return false
+}
+
+func objectFQN(o *unstructured.Unstructured) string {
+	if o.GetNamespace() == "" {
+		return client.FQN(client.ClusterScope, o.GetName())
+	}
+
+	return client.FQN(o.GetNamespace(), o.GetName())
+}
+
+// conditionsStatus sets a node status based on the resource status conditions.
+func conditionsStatus(n *TreeNode, o *unstructured.Unstructured) {
+	n.Extras[StatusKey] = OkStatus
+	if phase, ok, _ := unstructured.NestedString(o.Object, "status", "phase"); ok && phase != "" {
+		n.Extras[InfoKey] = phase
+	}
+
+	cc, _, _ := unstructured.NestedSlice(o.Object, "status", "conditions")
+	for _, c := range cc {
+		m, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		t, _ := m["type"].(string)
+		s, _ := m["status"].(string)
+		if !(healthyConditions.Has(t) && s == "False") && !(unhealthyConditions.Has(t) && s == "True") {
+			continue
+		}
+		n.Extras[StatusKey] = ToastStatus
+		info := t
+		if r, _ := m["reason"].(string); r != "" {
+			info += ":" + r
+		}
+		n.Extras[InfoKey] = info
+		return
+	}
+}
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	roGVR = client.NewGVR("argoproj.io/v1alpha1/rollouts")
+	xrGVR = client.NewGVR("example.org/v1alpha1/xbuckets")
+	mrGVR = client.NewGVR("s3.aws.upbound.io/v1beta1/buckets")
+)
+
+func init() {
+	mm := []struct {
+		gvr        *client.GVR
+		kind       string
+		namespaced bool
+	}{
+		{gvr: client.RsGVR, kind: "ReplicaSet", namespaced: true},
+		{gvr: client.PodGVR, kind: "Pod", namespaced: true},
+		{gvr: roGVR, kind: "Rollout", namespaced: true},
+		{gvr: xrGVR, kind: "XBucket"},
+		{gvr: mrGVR, kind: "Bucket"},
+	}
+	for _, m := range mm {
+		dao.MetaAccess.RegisterMeta(m.gvr.String(), &metav1.APIResource{
+			Name:       m.gvr.R(),
+			Group:      m.gvr.G(),
+			Version:    m.gvr.V(),
+			Kind:       m.kind,
+			Namespaced: m.namespaced,
+		})
+	}
+}
+
+func TestOwnerRender(t *testing.T) {
+	uu := map[string]struct {
+		file   string
+		rows   map[*client.GVR][]runtime.Object
+		path   []*client.GVR
+		status []string
+		info   string
+		pods   int
+	}{
+		"rollout": {
+			file: "rollout",
+			rows: map[*client.GVR][]runtime.Object{
+				client.RsGVR:  {load(t, "rs")},
+				client.PodGVR: {load(t, "po")},
+			},
+			path:   []*client.GVR{client.NsGVR, roGVR, client.RsGVR},
+			status: []string{xray.OkStatus, xray.ToastStatus, xray.OkStatus},
+			pods:   1,
+			info:   "Available:AvailableReason",
+		},
+		"composite": {
+			file: "xr",
+			rows: map[*client.GVR][]runtime.Object{
+				mrGVR: {load(t, "mr")},
+			},
+			path:   []*client.GVR{xrGVR, mrGVR},
+			status: []string{xray.OkStatus, xray.ToastStatus},
+		},
+		"leaf": {
+			file:   "xr",
+			path:   []*client.GVR{xrGVR},
+			status: []string{xray.OkStatus},
+		},
+	}
+
+	var re xray.Owner
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := makeFactory()
+			f.rows = u.rows
+			f.lists = make(map[*client.GVR]int)
+			root := xray.NewTreeNode(client.NoGVR, "root")
+			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+			ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+			require.NoError(t, re.Render(ctx, "", load(t, u.file)))
+			n := root
+			for i, gvr := range u.path {
+				require.Equal(t, 1, n.CountChildren())
+				n = n.Children[0]
+				assert.Equal(t, gvr, n.GVR)
+				assert.Equal(t, u.status[i], n.Extras[xray.StatusKey])
+			}
+			assert.Equal(t, u.pods, root.Count(client.PodGVR))
+			if u.info != "" {
+				assert.Equal(t, u.info, root.Children[0].Children[0].Extras[xray.InfoKey])
+			}
+			assert.Zero(t, f.lists[client.SecGVR])
+			assert.Zero(t, f.lists[client.CmGVR])
+		})
+	}
+}
+
+func TestOwnerRenderClusterScoped(t *testing.T) {
+	f := makeFactory()
+	f.rows = map[*client.GVR][]runtime.Object{mrGVR: {load(t, "mr")}}
+	f.lists = make(map[*client.GVR]int)
+	root := xray.NewTreeNode(client.NoGVR, "root")
+	ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+	ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+	var re xray.Owner
+	require.NoError(t, re.Render(ctx, "", load(t, "xr")))
+	require.Equal(t, 1, root.CountChildren())
+	xr := root.Children[0]
+	assert.Equal(t, "-/fred", xr.ID)
+	require.Equal(t, 1, xr.CountChildren())
+	assert.Equal(t, "-/fred-x8k2p", xr.Children[0].ID)
+	assert.Equal(t, "Synced:ReconcileError", xr.Children[0].Extras[xray.InfoKey])
+	assert.Equal(t, map[*client.GVR]int{xrGVR: 1, mrGVR: 2}, f.lists)
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// PodDisruptionBudget represents an xray renderer.
+type PodDisruptionBudget struct{}
+
+// Render renders an xray node.
+func (p *PodDisruptionBudget) Render(ctx context.Context, _ string, o any) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Unstructured, but got %T", o)
+	}
+	var pdb policyv1.PodDisruptionBudget
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &pdb)
+	if err != nil {
+		return err
+	}
+
+	parent, ok := ctx.Value(KeyParent).(*TreeNode)
+	if !ok {
+		return fmt.Errorf("expecting a TreeNode but got %T", ctx.Value(KeyParent))
+	}
+
+	root := NewTreeNode(client.PdbGVR, client.FQN(pdb.Namespace, pdb.Name))
+	oo, err := selectPods(ctx, pdb.Namespace, pdb.Spec.Selector)
+	if err != nil {
+		return err
+	}
+	if err := addPodsOwners(ctx, root, pdb.Namespace, oo); err != nil {
+		return err
+	}
+	addToNamespace(parent, pdb.Namespace, root)
+
+	return p.validate(root, &pdb)
+}
+
+func (*PodDisruptionBudget) validate(root *TreeNode, pdb *policyv1.PodDisruptionBudget) error {
+	root.Extras[StatusKey] = OkStatus
+	if root.IsLeaf() || pdb.Status.CurrentHealthy < pdb.Status.DesiredHealthy {
+		root.Extras[StatusKey] = ToastStatus
+	}
+	root.Extras[InfoKey] = fmt.Sprintf("%d/%d/%d",
+		pdb.Status.CurrentHealthy,
+		pdb.Status.DesiredHealthy,
+		pdb.Status.DisruptionsAllowed,
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// selectPods returns the pods matching a label selector. A nil selector
+// matches no pods and an empty one all pods.
+func selectPods(ctx context.Context, ns string, sel *metav1.LabelSelector) ([]runtime.Object, error) {
+	if sel == nil {
+		return nil, nil
+	}
+	s, err := metav1.LabelSelectorAsSelector(sel)
+	if err != nil {
+		return nil, err
+	}
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return nil, fmt.Errorf("expecting a factory but got %T", ctx.Value(internal.KeyFactory))
+	}
+
+	return f.List(client.PodGVR, ns, false, s)
+}
+
+// addPodsOwners adds the workloads controlling a collection of pods to a
+// given node. Pods with no known controller are added as is.
+func addPodsOwners(ctx context.Context, parent *TreeNode, ns string, oo []runtime.Object) error {
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return fmt.Errorf("expecting a factory but got %T", ctx.Value(internal.KeyFactory))
+	}
+
+	orphans := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		po, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return fmt.Errorf("expecting *Unstructured but got %T", o)
+		}
+		gvr, fqn, ok := controllerOf(f, po)
+		if !ok {
+			orphans = append(orphans, o)
+			continue
+		}
+		if _, err := addResource(ctx, parent, gvr, fqn); err != nil {
+			return err
+		}
+	}
+
+	return graftPods(ctx, parent, ns, orphans)
+}
+
+// controllerOf returns the top level workload controlling a given resource.
+func controllerOf(f dao.Factory, o *unstructured.Unstructured) (*client.GVR, string, bool) {
+	ref := metav1.GetControllerOf(o)
+	if ref == nil {
+		return nil, "", false
+	}
+	gvr, ok := workloadGVR(ref.Kind)
+	if !ok {
+		return nil, "", false
+	}
+	fqn := client.FQN(o.GetNamespace(), ref.Name)
+	if gvr != client.RsGVR {
+		return gvr, fqn, true
+	}
+
+	rs, err := f.Get(client.RsGVR, fqn, true, labels.Everything())
+	if err != nil || rs == nil {
+		return gvr, fqn, true
+	}
+	u, ok := rs.(*unstructured.Unstructured)
+	if !ok {
+		return gvr, fqn, true
+	}
+	if dgvr, dfqn, ok := controllerOf(f, u); ok {
+		return dgvr, dfqn, true
+	}
+
+	return gvr, fqn, true
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestPodDisruptionBudgetRender(t *testing.T) {
+	uu := map[string]struct {
+		rows     map[*client.GVR][]runtime.Object
+		children int
+		status   string
+	}{
+		"plain": {
+			rows:     map[*client.GVR][]runtime.Object{client.PodGVR: {load(t, "po")}},
+			children: 1,
+			status:   xray.OkStatus,
+		},
+		"noPods": {
+			status: xray.ToastStatus,
+		},
+	}
+
+	var re xray.PodDisruptionBudget
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := makeFactory()
+			f.rows = u.rows
+			root := xray.NewTreeNode(client.PdbGVR, "poddisruptionbudgets")
+			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+			ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+			require.NoError(t, re.Render(ctx, "", load(t, "pdb")))
+			require.Equal(t, 1, root.CountChildren())
+			pdb := root.Children[0].Children[0]
+			assert.Equal(t, u.status, pdb.Extras[xray.StatusKey])
+			assert.Equal(t, "1/1/0", pdb.Extras[xray.InfoKey])
+			assert.Equal(t, u.children, pdb.CountChildren())
+		})
+	}
+}
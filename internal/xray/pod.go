@@ -47,7 +47,7 @@ func (p *Pod) Render(ctx context.Context, ns string, o any) error {
 	if err := p.containerRefs(ctx, node, po.Namespace, &po.Spec); err != nil {
 		return err
 	}
-	p.podVolumeRefs(f, node, po.Namespace, po.Spec.Volumes)
+	p.podVolumeRefs(f, node, &po)
 	if err := p.serviceAccountRef(ctx, f, node, po.Namespace, &po.Spec); err != nil {
 		return err
 	}
@@ -125,23 +125,19 @@ func (*Pod) serviceAccountRef(ctx context.Context, f dao.Factory, parent *TreeNo
 	return saRE.Render(ctx, ns, o)
 }
 
-func (*Pod) podVolumeRefs(f dao.Factory, parent *TreeNode, ns string, vv []v1.Volume) {
-	for i := range vv {
-		sec := vv[i].Secret
-		if sec != nil {
-			addRef(f, parent, client.SecGVR, client.FQN(ns, sec.SecretName), sec.Optional)
-			continue
-		}
-
-		cm := vv[i].ConfigMap
-		if cm != nil {
-			addRef(f, parent, client.CmGVR, client.FQN(ns, cm.Name), cm.Optional)
-			continue
-		}
-
-		pvc := vv[i].PersistentVolumeClaim
-		if pvc != nil {
-			addRef(f, parent, client.PvcGVR, client.FQN(ns, pvc.ClaimName), nil)
+// podVolumeRefs adds the pod volume references. Only claim backed volumes
+// walk their claim volume and storage class.
+func (*Pod) podVolumeRefs(f dao.Factory, parent *TreeNode, po *v1.Pod) {
+	for _, v := range po.Spec.Volumes {
+		switch {
+		case v.Secret != nil:
+			addRef(f, parent, client.SecGVR, client.FQN(po.Namespace, v.Secret.SecretName), v.Secret.Optional)
+		case v.ConfigMap != nil:
+			addRef(f, parent, client.CmGVR, client.FQN(po.Namespace, v.ConfigMap.Name), v.ConfigMap.Optional)
+		case v.PersistentVolumeClaim != nil:
+			addPVCRef(f, parent, client.FQN(po.Namespace, v.PersistentVolumeClaim.ClaimName))
+		case v.Ephemeral != nil:
+			addPVCRef(f, parent, client.FQN(po.Namespace, po.Name+"-"+v.Name))
 		}
 	}
 }
@@ -13,6 +13,10 @@ import (
 	"github.com/derailed/k9s/internal/xray"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 func TestPodRender(t *testing.T) {
@@ -56,3 +60,51 @@ func TestPodRender(t *testing.T) {
 		})
 	}
 }
+
+func TestPodVolumeRefs(t *testing.T) {
+	uu := map[string]struct {
+		vv   []v1.Volume
+		pvcs []string
+	}{
+		"no-claims": {
+			vv: []v1.Volume{
+				{Name: "s1", VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "s1"}}},
+				{Name: "tmp", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}},
+			},
+		},
+		"claims": {
+			vv: []v1.Volume{
+				{Name: "web", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "web"}}},
+				{Name: "data", VolumeSource: v1.VolumeSource{Ephemeral: &v1.EphemeralVolumeSource{}}},
+			},
+			pvcs: []string{"default/p1-data", "default/web"},
+		},
+	}
+
+	var re xray.Pod
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			po := v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p1"},
+				Spec:       v1.PodSpec{Volumes: u.vv},
+			}
+			raw, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&po)
+			require.NoError(t, err)
+			f := makeFactory()
+			f.gets = make(map[*client.GVR]int)
+			root := xray.NewTreeNode(client.PodGVR, "pods")
+			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+			ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+			require.NoError(t, re.Render(ctx, "", &render.PodWithMetrics{Raw: &unstructured.Unstructured{Object: raw}}))
+			assert.Equal(t, len(u.pvcs), f.gets[client.PvcGVR])
+			assert.Zero(t, f.gets[client.PvGVR])
+			assert.Zero(t, f.gets[client.ScGVR])
+			assert.Equal(t, len(u.pvcs), root.Count(client.PvcGVR))
+			for _, id := range u.pvcs {
+				assert.NotNil(t, root.Find(client.PvcGVR, id))
+			}
+		})
+	}
+}
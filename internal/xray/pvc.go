@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// PersistentVolumeClaim represents an xray renderer.
+type PersistentVolumeClaim struct{}
+
+// Render renders an xray node.
+func (*PersistentVolumeClaim) Render(ctx context.Context, _ string, o any) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Unstructured, but got %T", o)
+	}
+	var pvc v1.PersistentVolumeClaim
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &pvc)
+	if err != nil {
+		return err
+	}
+
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return fmt.Errorf("no factory found in context")
+	}
+	parent, ok := ctx.Value(KeyParent).(*TreeNode)
+	if !ok {
+		return fmt.Errorf("expecting a TreeNode but got %T", ctx.Value(KeyParent))
+	}
+
+	root := NewTreeNode(client.PvcGVR, client.FQN(pvc.Namespace, pvc.Name))
+	pvcChain(f, root, &pvc)
+	addToNamespace(parent, pvc.Namespace, root)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// addPVCRef adds a claim along with its volume and storage class to a given node.
+func addPVCRef(f dao.Factory, parent *TreeNode, fqn string) {
+	if parent.Find(client.PvcGVR, fqn) != nil {
+		return
+	}
+	n := NewTreeNode(client.PvcGVR, fqn)
+	parent.Add(n)
+
+	o, err := f.Get(client.PvcGVR, fqn, true, labels.Everything())
+	if err != nil || o == nil {
+		n.Extras[StatusKey] = MissingRefStatus
+		return
+	}
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		n.Extras[StatusKey] = MissingRefStatus
+		return
+	}
+	var pvc v1.PersistentVolumeClaim
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &pvc); err != nil {
+		n.Extras[StatusKey] = MissingRefStatus
+		return
+	}
+	pvcChain(f, n, &pvc)
+}
+
+// pvcChain adds a claim volume and storage class to the claim node.
+func pvcChain(f dao.Factory, n *TreeNode, pvc *v1.PersistentVolumeClaim) {
+	n.Extras[StatusKey] = OkStatus
+	if pvc.Status.Phase != v1.ClaimBound {
+		n.Extras[StatusKey] = ToastStatus
+	}
+	n.Extras[InfoKey] = string(pvc.Status.Phase)
+
+	var sc string
+	if pvc.Spec.StorageClassName != nil {
+		sc = *pvc.Spec.StorageClassName
+	}
+	scParent := n
+	if pvc.Spec.VolumeName != "" {
+		pvn := NewTreeNode(client.PvGVR, client.FQN(client.ClusterScope, pvc.Spec.VolumeName))
+		n.Add(pvn)
+		if pv, ok := getPV(f, pvn.ID); ok {
+			pvn.Extras[StatusKey] = OkStatus
+			if pv.Status.Phase != v1.VolumeBound {
+				pvn.Extras[StatusKey] = ToastStatus
+			}
+			if q, ok := pv.Spec.Capacity[v1.ResourceStorage]; ok {
+				pvn.Extras[InfoKey] = q.String()
+			}
+			if pv.Spec.StorageClassName != "" {
+				sc = pv.Spec.StorageClassName
+			}
+			scParent = pvn
+		} else {
+			pvn.Extras[StatusKey] = MissingRefStatus
+		}
+	}
+	if sc != "" {
+		addRef(f, scParent, client.ScGVR, client.FQN(client.ClusterScope, sc), nil)
+	}
+}
+
+func getPV(f dao.Factory, fqn string) (*v1.PersistentVolume, bool) {
+	o, err := f.Get(client.PvGVR, fqn, true, labels.Everything())
+	if err != nil || o == nil {
+		return nil, false
+	}
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, false
+	}
+	var pv v1.PersistentVolume
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &pv); err != nil {
+		return nil, false
+	}
+
+	return &pv, true
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestPersistentVolumeClaimRender(t *testing.T) {
+	uu := map[string]struct {
+		rows     map[*client.GVR][]runtime.Object
+		pvStatus string
+		children int
+	}{
+		"plain": {
+			rows: map[*client.GVR][]runtime.Object{
+				client.PvGVR: {load(t, "pv")},
+				client.ScGVR: {load(t, "sc")},
+			},
+			pvStatus: xray.OkStatus,
+			children: 1,
+		},
+		"missingPV": {
+			rows: map[*client.GVR][]runtime.Object{
+				client.ScGVR: {load(t, "sc")},
+			},
+			pvStatus: xray.MissingRefStatus,
+			children: 2,
+		},
+	}
+
+	var re xray.PersistentVolumeClaim
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := makeFactory()
+			f.rows = u.rows
+			root := xray.NewTreeNode(client.PvcGVR, "persistentvolumeclaims")
+			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+			ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+			require.NoError(t, re.Render(ctx, "", load(t, "pvc")))
+			require.Equal(t, 1, root.CountChildren())
+			pvc := root.Children[0].Children[0]
+			assert.Equal(t, xray.OkStatus, pvc.Extras[xray.StatusKey])
+			assert.Equal(t, "Bound", pvc.Extras[xray.InfoKey])
+			require.Equal(t, u.children, pvc.CountChildren())
+
+			pv := pvc.Children[0]
+			assert.Equal(t, client.PvGVR, pv.GVR)
+			assert.Equal(t, u.pvStatus, pv.Extras[xray.StatusKey])
+			assert.Equal(t, 1, root.Count(client.ScGVR))
+		})
+	}
+}
@@ -498,6 +498,10 @@ func toEmoji(gvr *client.GVR) string {
 		return "👨🏻‍"
 	case client.NpGVR:
 		return "📕"
+	case client.IngGVR:
+		return "🌐"
+	case client.ScGVR:
+		return "🗄 "
 	case client.PdbGVR:
 		return "🏷 "
 	case client.PspGVR:
@@ -581,6 +585,11 @@ func EmojiInfo() map[string]string {
 		client.DpGVR,
 		client.StsGVR,
 		client.DsGVR,
+		client.IngGVR,
+		client.HpaGVR,
+		client.PdbGVR,
+		client.NpGVR,
+		client.ScGVR,
 	}
 
 	m := make(map[string]string, len(gvrs))
@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// renderer represents an xray node renderer.
+type renderer interface {
+	Render(ctx context.Context, ns string, o any) error
+}
+
+// workloadRenderer returns the renderer for a given workload or nil if none.
+func workloadRenderer(gvr *client.GVR) renderer {
+	switch gvr {
+	case client.DpGVR:
+		return new(Deployment)
+	case client.StsGVR:
+		return new(StatefulSet)
+	case client.DsGVR:
+		return new(DaemonSet)
+	case client.RsGVR:
+		return new(ReplicaSet)
+	case client.SvcGVR:
+		return new(Service)
+	default:
+		return nil
+	}
+}
+
+// workloadGVR returns a workload gvr given its kind.
+func workloadGVR(kind string) (*client.GVR, bool) {
+	switch kind {
+	case "Deployment":
+		return client.DpGVR, true
+	case "StatefulSet":
+		return client.StsGVR, true
+	case "DaemonSet":
+		return client.DsGVR, true
+	case "ReplicaSet":
+		return client.RsGVR, true
+	default:
+		return nil, false
+	}
+}
+
+// addResource adds a resource subtree to a given node. Missing resources are
+// flagged as such.
+func addResource(ctx context.Context, parent *TreeNode, gvr *client.GVR, fqn string) (*TreeNode, error) {
+	if n := parent.Find(gvr, fqn); n != nil {
+		return n, nil
+	}
+
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return nil, fmt.Errorf("expecting a factory but got %T", ctx.Value(internal.KeyFactory))
+	}
+	o, err := f.Get(gvr, fqn, true, labels.Everything())
+	if err != nil || o == nil {
+		slog.Warn("Missing ref",
+			slogs.GVR, gvr,
+			slogs.ID, fqn,
+			slogs.Error, err,
+		)
+		n := NewTreeNode(gvr, fqn)
+		n.Extras[StatusKey] = MissingRefStatus
+		parent.Add(n)
+		return n, nil
+	}
+
+	if re := workloadRenderer(gvr); re != nil {
+		ns, _ := client.Namespaced(fqn)
+		if err := graft(ctx, parent, re, ns, o); err != nil {
+			return nil, err
+		}
+	}
+	n := parent.Find(gvr, fqn)
+	if n == nil {
+		n = NewTreeNode(gvr, fqn)
+		parent.Add(n)
+	}
+
+	return n, nil
+}
+
+// graft renders a resource and adds the resulting nodes to a given node,
+// skipping the namespace level.
+func graft(ctx context.Context, parent *TreeNode, re renderer, ns string, o any) error {
+	scratch := NewTreeNode(client.NoGVR, "")
+	if err := re.Render(context.WithValue(ctx, KeyParent, scratch), ns, o); err != nil {
+		return err
+	}
+	for _, nsn := range scratch.Children {
+		for _, c := range nsn.Children {
+			parent.Add(c)
+		}
+	}
+
+	return nil
+}
+
+// graftPods renders a collection of pods under a given node.
+func graftPods(ctx context.Context, parent *TreeNode, ns string, oo []runtime.Object) error {
+	var re Pod
+	for _, o := range oo {
+		p, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return fmt.Errorf("expecting *Unstructured but got %T", o)
+		}
+		if err := graft(ctx, parent, &re, ns, &render.PodWithMetrics{Raw: p}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addToNamespace adds a node to its namespace node under a given parent.
+func addToNamespace(parent *TreeNode, ns string, n *TreeNode) {
+	gvr, nsID := client.NsGVR, client.FQN(client.ClusterScope, ns)
+	nsn := parent.Find(gvr, nsID)
+	if nsn == nil {
+		nsn = NewTreeNode(gvr, nsID)
+		parent.Add(nsn)
+	}
+	nsn.Add(n)
+}
+
+// hasMissingRefs checks if any child of a node is a missing reference.
+func hasMissingRefs(n *TreeNode) bool {
+	for _, c := range n.Children {
+		if c.Extras[StatusKey] == MissingRefStatus {
+			return true
+		}
+	}
+
+	return false
+}